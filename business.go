@@ -0,0 +1,217 @@
+package jptime
+
+import (
+	"sync"
+	"time"
+)
+
+// A Calendar defines business days.
+// A day is closed if it is a rest day of the week or a holiday reported by Holiday,
+// or if it is closed by Close or CloseEveryYear, unless it is opened by Open.
+// A Calendar is safe for concurrent use.
+type Calendar struct {
+	mu       sync.RWMutex
+	restDays [7]bool
+	closed   map[int]string // YYYYMMDD
+	annual   map[int]string // MMDD
+	opened   map[int]bool   // YYYYMMDD
+}
+
+// NewCalendar returns a Calendar closed on Saturdays, Sundays and holidays.
+func NewCalendar() *Calendar {
+	c := &Calendar{
+		closed: make(map[int]string),
+		annual: make(map[int]string),
+		opened: make(map[int]bool),
+	}
+	c.SetRestDays(time.Saturday, time.Sunday)
+	return c
+}
+
+var defaultCalendar = NewCalendar()
+
+// NewBankCalendar returns a Calendar of 銀行休業日 (銀行法施行令第5条),
+// closed on Saturdays, Sundays, holidays and from December 31 to January 3.
+func NewBankCalendar() *Calendar {
+	c := NewCalendar()
+	c.CloseEveryYear(time.December, 31, "年末年始")
+	c.CloseEveryYear(time.January, 1, "年末年始")
+	c.CloseEveryYear(time.January, 2, "年末年始")
+	c.CloseEveryYear(time.January, 3, "年末年始")
+	return c
+}
+
+// SetRestDays sets the weekly rest days, replacing the previous ones.
+func (c *Calendar) SetRestDays(days ...time.Weekday) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.restDays = [7]bool{}
+	for _, d := range days {
+		c.restDays[d] = true
+	}
+}
+
+// Close closes the date of t with the name such as 創立記念日.
+func (c *Calendar) Close(t JpTime, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.opened, t.ymd())
+	c.closed[t.ymd()] = name
+}
+
+// CloseEveryYear closes month/day of every year with the name such as 年末年始.
+func (c *Calendar) CloseEveryYear(month time.Month, day int, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.annual[int(month)*100+day] = name
+}
+
+// Open opens the date of t even if it is a rest day, a holiday or closed.
+func (c *Calendar) Open(t JpTime) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.closed, t.ymd())
+	c.opened[t.ymd()] = true
+}
+
+// Closed reports whether t is closed and its reason:
+// the name given to Close or CloseEveryYear, the holiday name, or the weekday such as 土曜日.
+func (c *Calendar) Closed(t JpTime) (bool, string) {
+	ymd := t.ymd()
+	c.mu.RLock()
+	if c.opened[ymd] {
+		c.mu.RUnlock()
+		return false, ""
+	}
+	if name, ok := c.closed[ymd]; ok {
+		c.mu.RUnlock()
+		return true, name
+	}
+	if name, ok := c.annual[ymd%10000]; ok {
+		c.mu.RUnlock()
+		return true, name
+	}
+	rest := c.restDays[t.Weekday()]
+	c.mu.RUnlock()
+
+	if isHoliday, name := t.Holiday(); isHoliday {
+		return true, name
+	}
+	if rest {
+		return true, t.JpWeekday().String() + "曜日"
+	}
+	return false, ""
+}
+
+// IsBusinessDay reports whether t is a business day of c.
+func (c *Calendar) IsBusinessDay(t JpTime) bool {
+	closed, _ := c.Closed(t)
+	return !closed
+}
+
+// A BusinessDayRule specifies how Adjust moves a date that is not a business day.
+type BusinessDayRule int
+
+// These are the rules for Adjust.
+const (
+	Following         BusinessDayRule = iota // 翌営業日
+	Preceding                                // 前営業日
+	ModifiedFollowing                        // 翌営業日、月を跨ぐ場合は前営業日
+	ModifiedPreceding                        // 前営業日、月を跨ぐ場合は翌営業日
+)
+
+// Adjust returns t if it is a business day of c, otherwise the business day given by rule.
+// It is used for 振込日 and 引落日 falling on a closed day.
+// It returns the zero JpTime if there is no such business day, as AddBusinessDays does.
+func (c *Calendar) Adjust(t JpTime, rule BusinessDayRule) JpTime {
+	if c.IsBusinessDay(t) {
+		return t
+	}
+	switch rule {
+	case Following:
+		return c.NextBusinessDay(t)
+	case Preceding:
+		return c.PrevBusinessDay(t)
+	case ModifiedFollowing:
+		if next := c.NextBusinessDay(t); next.Month() == t.Month() {
+			return next
+		}
+		return c.PrevBusinessDay(t)
+	case ModifiedPreceding:
+		if prev := c.PrevBusinessDay(t); prev.Month() == t.Month() {
+			return prev
+		}
+		return c.NextBusinessDay(t)
+	}
+	return t
+}
+
+// NextBusinessDay returns the first business day of c after t,
+// or the zero JpTime if there is none as for AddBusinessDays.
+func (c *Calendar) NextBusinessDay(t JpTime) JpTime {
+	return c.AddBusinessDays(t, 1)
+}
+
+// PrevBusinessDay returns the last business day of c before t,
+// or the zero JpTime if there is none as for AddBusinessDays.
+func (c *Calendar) PrevBusinessDay(t JpTime) JpTime {
+	return c.AddBusinessDays(t, -1)
+}
+
+// AddBusinessDays returns t moved n business days of c forward, or backward if n is negative.
+// The time of day is kept. If n is 0, t is returned even if it is not a business day.
+// It returns the zero JpTime if no business day is found for a year,
+// for example when SetRestDays is given all the days of the week.
+func (c *Calendar) AddBusinessDays(t JpTime, n int) JpTime {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	closed := 0
+	for n > 0 {
+		t = NewJpTime(t.AddDate(0, 0, step))
+		if c.IsBusinessDay(t) {
+			n--
+			closed = 0
+		} else if closed++; closed >= 366 {
+			return JpTime{}
+		}
+	}
+	return t
+}
+
+// BusinessDaysBetween returns the number of business days of c from the date of a to the date of b,
+// excluding a and including b, so that c.AddBusinessDays(a, n) falls on b when b is a business day.
+// It is negative if b is before a.
+func (c *Calendar) BusinessDaysBetween(a, b JpTime) int {
+	step, last := 1, b.ymd()
+	if last < a.ymd() {
+		step = -1
+	}
+	n := 0
+	for t := NewJpTime(a.AddDate(0, 0, step)); (t.ymd()-last)*step <= 0; t = NewJpTime(t.AddDate(0, 0, step)) {
+		if c.IsBusinessDay(t) {
+			n += step
+		}
+	}
+	return n
+}
+
+// IsBusinessDay reports whether t is a business day,
+// which is neither Saturday, Sunday nor a holiday reported by Holiday.
+func (t JpTime) IsBusinessDay() bool { return defaultCalendar.IsBusinessDay(t) }
+
+// NextBusinessDay returns the first business day after t.
+func (t JpTime) NextBusinessDay() JpTime { return defaultCalendar.NextBusinessDay(t) }
+
+// PrevBusinessDay returns the last business day before t.
+func (t JpTime) PrevBusinessDay() JpTime { return defaultCalendar.PrevBusinessDay(t) }
+
+// AddBusinessDays returns t moved n business days forward, or backward if n is negative.
+// The time of day is kept. If n is 0, t is returned even if it is not a business day.
+func (t JpTime) AddBusinessDays(n int) JpTime { return defaultCalendar.AddBusinessDays(t, n) }
+
+// BusinessDaysBetween returns the number of business days from the date of a to the date of b,
+// excluding a and including b, so that a.AddBusinessDays(n) falls on b when b is a business day.
+// It is negative if b is before a.
+func BusinessDaysBetween(a, b JpTime) int { return defaultCalendar.BusinessDaysBetween(a, b) }
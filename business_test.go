@@ -0,0 +1,234 @@
+package jptime
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+var businessdaytests = []struct {
+	time time.Time
+	ok   bool
+}{
+	{time.Date(2019, time.April, 26, 0, 0, 0, 0, jst), true},
+	{time.Date(2019, time.April, 27, 0, 0, 0, 0, jst), false},
+	{time.Date(2019, time.April, 28, 0, 0, 0, 0, jst), false},
+	{time.Date(2019, time.April, 30, 0, 0, 0, 0, jst), false},
+	{time.Date(2019, time.May, 6, 0, 0, 0, 0, jst), false},
+	{time.Date(2019, time.May, 7, 0, 0, 0, 0, jst), true},
+	{time.Date(2016, time.December, 31, 0, 0, 0, 0, jst), false},
+	{time.Date(2016, time.December, 30, 0, 0, 0, 0, jst), true},
+}
+
+func TestJpTime_IsBusinessDay(t *testing.T) {
+	for _, test := range businessdaytests {
+		if ok := NewJpTime(test.time).IsBusinessDay(); ok != test.ok {
+			t.Errorf("JpTime_IsBusinessDay %v = %v", test.time, ok)
+		}
+	}
+}
+
+var addbusinessdaystests = []struct {
+	time   time.Time
+	n      int
+	result time.Time
+}{
+	{time.Date(2019, time.April, 26, 15, 0, 0, 0, jst), 1, time.Date(2019, time.May, 7, 15, 0, 0, 0, jst)},
+	{time.Date(2019, time.April, 26, 0, 0, 0, 0, jst), 2, time.Date(2019, time.May, 8, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.May, 7, 0, 0, 0, 0, jst), -1, time.Date(2019, time.April, 26, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.May, 3, 0, 0, 0, 0, jst), -1, time.Date(2019, time.April, 26, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.May, 3, 0, 0, 0, 0, jst), 0, time.Date(2019, time.May, 3, 0, 0, 0, 0, jst)},
+	{time.Date(2016, time.January, 4, 0, 0, 0, 0, jst), 20, time.Date(2016, time.February, 2, 0, 0, 0, 0, jst)},
+}
+
+func TestJpTime_AddBusinessDays(t *testing.T) {
+	for _, test := range addbusinessdaystests {
+		jpt := NewJpTime(test.time)
+		if result := jpt.AddBusinessDays(test.n); !result.Equal(test.result) {
+			t.Errorf("JpTime_AddBusinessDays %v %d = %v, want %v", test.time, test.n, result, test.result)
+		}
+		if n := BusinessDaysBetween(jpt, NewJpTime(test.result)); NewJpTime(test.result).IsBusinessDay() && n != test.n {
+			t.Errorf("BusinessDaysBetween %v %v = %d, want %d", test.time, test.result, n, test.n)
+		}
+	}
+}
+
+func TestJpTime_NextBusinessDay(t *testing.T) {
+	jpt := NewJpTime(time.Date(2019, time.April, 27, 9, 0, 0, 0, jst))
+	if next := jpt.NextBusinessDay(); !next.Equal(time.Date(2019, time.May, 7, 9, 0, 0, 0, jst)) {
+		t.Errorf("JpTime_NextBusinessDay %v = %v", jpt, next)
+	}
+	if prev := jpt.PrevBusinessDay(); !prev.Equal(time.Date(2019, time.April, 26, 9, 0, 0, 0, jst)) {
+		t.Errorf("JpTime_PrevBusinessDay %v = %v", jpt, prev)
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	a := NewJpTime(time.Date(2019, time.April, 26, 18, 0, 0, 0, jst))
+	b := NewJpTime(time.Date(2019, time.May, 10, 9, 0, 0, 0, jst))
+	if n := BusinessDaysBetween(a, b); n != 4 {
+		t.Errorf("BusinessDaysBetween %v %v = %d, want 4", a, b, n)
+	}
+	if n := BusinessDaysBetween(b, a); n != -4 {
+		t.Errorf("BusinessDaysBetween %v %v = %d, want -4", b, a, n)
+	}
+	if n := BusinessDaysBetween(a, a); n != 0 {
+		t.Errorf("BusinessDaysBetween %v %v = %d, want 0", a, a, n)
+	}
+}
+
+func newTestCalendar() *Calendar {
+	c := NewCalendar()
+	c.CloseEveryYear(time.December, 29, "年末年始")
+	c.CloseEveryYear(time.December, 30, "年末年始")
+	c.CloseEveryYear(time.December, 31, "年末年始")
+	c.CloseEveryYear(time.January, 2, "年末年始")
+	c.CloseEveryYear(time.January, 3, "年末年始")
+	c.Close(NewJpTime(time.Date(2019, time.August, 13, 0, 0, 0, 0, jst)), "お盆")
+	c.Close(NewJpTime(time.Date(2019, time.June, 3, 0, 0, 0, 0, jst)), "創立記念日")
+	c.Open(NewJpTime(time.Date(2019, time.May, 2, 0, 0, 0, 0, jst)))
+	c.Open(NewJpTime(time.Date(2019, time.June, 8, 0, 0, 0, 0, jst)))
+	return c
+}
+
+var calendarclosedtests = []JpTimeTest{
+	{time.Date(2019, time.December, 30, 0, 0, 0, 0, jst), "年末年始"},
+	{time.Date(2020, time.January, 3, 0, 0, 0, 0, jst), "年末年始"},
+	{time.Date(2020, time.January, 6, 0, 0, 0, 0, jst), ""},
+	{time.Date(2019, time.August, 13, 0, 0, 0, 0, jst), "お盆"},
+	{time.Date(2020, time.August, 13, 0, 0, 0, 0, jst), ""},
+	{time.Date(2019, time.June, 3, 0, 0, 0, 0, jst), "創立記念日"},
+	{time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), "即位の日"},
+	{time.Date(2019, time.May, 2, 0, 0, 0, 0, jst), ""},
+	{time.Date(2019, time.June, 8, 0, 0, 0, 0, jst), ""},
+	{time.Date(2019, time.June, 9, 0, 0, 0, 0, jst), "日曜日"},
+}
+
+func TestCalendar_Closed(t *testing.T) {
+	c := newTestCalendar()
+	for _, test := range calendarclosedtests {
+		closed, name := c.Closed(NewJpTime(test.time))
+		if closed != (test.str != "") || name != test.str {
+			t.Errorf("Calendar_Closed %v = %v %q, want %q", test.time, closed, name, test.str)
+		}
+	}
+}
+
+func TestCalendar_SetRestDays(t *testing.T) {
+	c := NewCalendar()
+	c.SetRestDays(time.Wednesday)
+	sat := NewJpTime(time.Date(2019, time.June, 8, 0, 0, 0, 0, jst))
+	if !c.IsBusinessDay(sat) {
+		t.Errorf("Calendar_IsBusinessDay %v = false", sat)
+	}
+	if next := c.NextBusinessDay(NewJpTime(time.Date(2019, time.June, 11, 0, 0, 0, 0, jst))); next.Day() != 13 {
+		t.Errorf("Calendar_NextBusinessDay = %v", next)
+	}
+	c.SetRestDays()
+	if sun := NewJpTime(time.Date(2019, time.June, 9, 0, 0, 0, 0, jst)); !c.IsBusinessDay(sun) {
+		t.Errorf("Calendar_IsBusinessDay %v = false", sun)
+	}
+}
+
+func TestCalendar_AddBusinessDays(t *testing.T) {
+	c := newTestCalendar()
+	from := NewJpTime(time.Date(2019, time.December, 27, 0, 0, 0, 0, jst))
+	to := c.AddBusinessDays(from, 1)
+	if !to.Equal(time.Date(2020, time.January, 6, 0, 0, 0, 0, jst)) {
+		t.Errorf("Calendar_AddBusinessDays %v 1 = %v", from, to)
+	}
+	if prev := c.PrevBusinessDay(to); !prev.Equal(from.Time) {
+		t.Errorf("Calendar_PrevBusinessDay %v = %v", to, prev)
+	}
+	if n := c.BusinessDaysBetween(from, to); n != 1 {
+		t.Errorf("Calendar_BusinessDaysBetween %v %v = %d, want 1", from, to, n)
+	}
+	if n := BusinessDaysBetween(from, to); n != 5 {
+		t.Errorf("BusinessDaysBetween %v %v = %d, want 5", from, to, n)
+	}
+}
+
+func TestCalendar_NoBusinessDay(t *testing.T) {
+	c := NewCalendar()
+	c.SetRestDays(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	jpt := NewJpTime(time.Date(2019, time.June, 11, 0, 0, 0, 0, jst))
+	if next := c.NextBusinessDay(jpt); !next.IsZero() {
+		t.Errorf("Calendar_NextBusinessDay with no business day = %v", next)
+	}
+	if prev := c.AddBusinessDays(jpt, -3); !prev.IsZero() {
+		t.Errorf("Calendar_AddBusinessDays with no business day = %v", prev)
+	}
+	if adjusted := c.Adjust(jpt, ModifiedFollowing); !adjusted.IsZero() {
+		t.Errorf("Calendar_Adjust with no business day = %v", adjusted)
+	}
+
+	c.Open(NewJpTime(time.Date(2020, time.June, 1, 0, 0, 0, 0, jst)))
+	if next := c.NextBusinessDay(jpt); next.Format("2006-01-02") != "2020-06-01" {
+		t.Errorf("Calendar_NextBusinessDay with an opened day = %v", next)
+	}
+}
+
+func TestCalendar_Concurrent(t *testing.T) {
+	c := NewCalendar()
+	day := NewJpTime(time.Date(2019, time.June, 3, 0, 0, 0, 0, jst))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			c.Close(day, "創立記念日")
+			c.Open(day)
+		}()
+		go func() {
+			defer wg.Done()
+			c.IsBusinessDay(day)
+		}()
+	}
+	wg.Wait()
+}
+
+var bankcalendartests = []JpTimeTest{
+	{time.Date(2018, time.December, 31, 0, 0, 0, 0, jst), "年末年始"},
+	{time.Date(2019, time.January, 1, 0, 0, 0, 0, jst), "年末年始"},
+	{time.Date(2019, time.January, 3, 0, 0, 0, 0, jst), "年末年始"},
+	{time.Date(2019, time.January, 4, 0, 0, 0, 0, jst), ""},
+	{time.Date(2018, time.December, 28, 0, 0, 0, 0, jst), ""},
+	{time.Date(2019, time.January, 14, 0, 0, 0, 0, jst), "成人の日"},
+	{time.Date(2019, time.January, 5, 0, 0, 0, 0, jst), "土曜日"},
+}
+
+func TestNewBankCalendar(t *testing.T) {
+	c := NewBankCalendar()
+	for _, test := range bankcalendartests {
+		closed, name := c.Closed(NewJpTime(test.time))
+		if closed != (test.str != "") || name != test.str {
+			t.Errorf("BankCalendar_Closed %v = %v %q, want %q", test.time, closed, name, test.str)
+		}
+	}
+}
+
+var adjusttests = []struct {
+	time   time.Time
+	rule   BusinessDayRule
+	result time.Time
+}{
+	{time.Date(2019, time.June, 7, 0, 0, 0, 0, jst), Following, time.Date(2019, time.June, 7, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.June, 8, 0, 0, 0, 0, jst), Following, time.Date(2019, time.June, 10, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.June, 8, 0, 0, 0, 0, jst), Preceding, time.Date(2019, time.June, 7, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.June, 30, 0, 0, 0, 0, jst), Following, time.Date(2019, time.July, 1, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.June, 30, 0, 0, 0, 0, jst), ModifiedFollowing, time.Date(2019, time.June, 28, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.June, 8, 0, 0, 0, 0, jst), ModifiedFollowing, time.Date(2019, time.June, 10, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.June, 1, 0, 0, 0, 0, jst), ModifiedPreceding, time.Date(2019, time.June, 3, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.June, 9, 0, 0, 0, 0, jst), ModifiedPreceding, time.Date(2019, time.June, 7, 0, 0, 0, 0, jst)},
+	{time.Date(2018, time.December, 31, 0, 0, 0, 0, jst), Following, time.Date(2019, time.January, 4, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.January, 3, 0, 0, 0, 0, jst), Preceding, time.Date(2018, time.December, 28, 0, 0, 0, 0, jst)},
+}
+
+func TestCalendar_Adjust(t *testing.T) {
+	c := NewBankCalendar()
+	for _, test := range adjusttests {
+		if result := c.Adjust(NewJpTime(test.time), test.rule); !result.Equal(test.result) {
+			t.Errorf("Calendar_Adjust %v %v = %v, want %v", test.time, test.rule, result, test.result)
+		}
+	}
+}
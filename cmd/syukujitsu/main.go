@@ -0,0 +1,49 @@
+// Command syukujitsu compares syukujitsu.csv published by the Cabinet Office with jptime.
+//
+//	syukujitsu [-from year] syukujitsu.csv
+//
+// It prints the dates on which they differ and exits with status 1 if any.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jackpopper/jptime"
+	"github.com/jackpopper/jptime/syukujitsu"
+)
+
+func main() {
+	from := flag.Int("from", 1955, "first year to compare")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: syukujitsu [-from year] syukujitsu.csv")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	defer f.Close()
+	entries, err := syukujitsu.Read(f)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	mismatches := syukujitsu.Diff(entries, jptime.NewJpTime(time.Date(*from, time.January, 1, 0, 0, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60))))
+	for _, m := range mismatches {
+		fmt.Println(m)
+	}
+	if len(mismatches) > 0 {
+		os.Exit(1)
+	}
+}
@@ -2,6 +2,7 @@ package jptime
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -11,10 +12,31 @@ func ExampleFmtInt() {
 	// 2016
 }
 
+func ExampleFmtIntZenkaku() {
+	fmt.Println(FmtIntZenkaku(2016))
+	// Output:
+	// ２０１６
+}
+
 func ExampleFmtIntKanji() {
 	fmt.Println(FmtIntKanji(2016))
+	fmt.Println(FmtIntKanji(0))
+	fmt.Println(FmtIntKanji(-5))
+	fmt.Println(FmtIntKanji(-5, Fu))
 	// Output:
 	// 二〇一六
+	// 〇
+	// マイナス五
+	// 負五
+}
+
+func ExampleAppendFmtIntKanji() {
+	buf := []byte("第")
+	buf = AppendFmtIntKanji(buf, 12)
+	buf = append(buf, "回"...)
+	fmt.Println(string(buf))
+	// Output:
+	// 第一二回
 }
 
 func ExampleFmtIntKanjiMeisuu() {
@@ -23,6 +45,36 @@ func ExampleFmtIntKanjiMeisuu() {
 	// 九十九
 }
 
+func ExampleFmtInt64KanjiMeisuu() {
+	fmt.Println(FmtInt64KanjiMeisuu(123456789))
+	fmt.Println(FmtInt64KanjiMeisuu(12345678, Issen))
+	// Output:
+	// 一億二千三百四十五万六千七百八十九
+	// 一千二百三十四万五千六百七十八
+}
+
+func ExampleFmtIntDaiji() {
+	fmt.Println(FmtIntDaiji(12345))
+	fmt.Println(FmtIntDaiji(12345, Kyuudaiji))
+	fmt.Println(FmtIntDaiji(1010))
+	// Output:
+	// 壱万弐千参百四拾五
+	// 壱萬弐阡参佰肆拾伍
+	// 壱千壱拾
+}
+
+func ExampleParseKanjiInt() {
+	for _, s := range []string{"二〇一六", "二千十六", "十八", "壱萬", "一億二千三百四十五万六千七百八十九"} {
+		fmt.Println(ParseKanjiInt(s))
+	}
+	// Output:
+	// 2016 <nil>
+	// 2016 <nil>
+	// 18 <nil>
+	// 10000 <nil>
+	// 123456789 <nil>
+}
+
 func ExampleJpYear_String() {
 	t := NewJpTime(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.Local))
 	fmt.Println(t.JpYear().String())
@@ -80,22 +132,60 @@ func ExampleJpTime_KyuurekiMonth() {
 }
 
 func ExampleJpTime_Wareki() {
-	t := NewJpTime(time.Date(0, time.January, 1, 0, 0, 0, 0, time.Local))
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	t := NewJpTime(time.Date(0, time.January, 1, 0, 0, 0, 0, jst))
 	fmt.Println(t.Wareki())
-	t = NewJpTime(time.Date(1872, time.January, 1, 0, 0, 0, 0, time.Local))
+	t = NewJpTime(time.Date(1872, time.January, 1, 0, 0, 0, 0, jst))
 	fmt.Println(t.Wareki())
-	t = NewJpTime(time.Date(1989, time.January, 1, 0, 0, 0, 0, time.Local))
+	t = NewJpTime(time.Date(1989, time.January, 1, 0, 0, 0, 0, jst))
 	fmt.Println(t.Wareki())
-	t = NewJpTime(time.Date(1989, time.December, 31, 0, 0, 0, 0, time.Local))
+	t = NewJpTime(time.Date(1989, time.December, 31, 0, 0, 0, 0, jst))
 	fmt.Println(t.Wareki())
-	t = NewJpTime(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.Local))
+	t = NewJpTime(time.Date(2016, time.January, 1, 0, 0, 0, 0, jst))
+	fmt.Println(t.Wareki())
+	t = NewJpTime(time.Date(2019, time.May, 1, 0, 0, 0, 0, jst))
 	fmt.Println(t.Wareki())
 	// Output:
 	// 紀元前 B.C. 1
-	// 西暦 A.D. 1872
+	// 明治 M 5
 	// 昭和 S 64
 	// 平成 H 1
 	// 平成 H 28
+	// 令和 R 1
+}
+
+func ExampleJpTime_Era() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	t := NewJpTime(time.Date(2019, time.May, 1, 0, 0, 0, 0, jst))
+	e := t.Era()
+	switch e.Wareki {
+	case Heisei:
+		fmt.Println("平成")
+	case Reiwa:
+		fmt.Println(e, e.Romaji, e.Reading)
+	}
+	// Output:
+	// 令和1年 Reiwa れいわ
+}
+
+func ExampleEra_Date() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	e := NewJpTime(time.Date(2016, time.January, 1, 0, 0, 0, 0, jst)).Era()
+	fmt.Println(e.Date(18, time.January, 2).Format("2006-01-02"))
+	// Output:
+	// 2006-01-02
+}
+
+func ExampleJpTime_WarekiCourt() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	t := NewJpTime(time.Date(1340, time.July, 1, 0, 0, 0, 0, jst))
+	name, _, year := t.WarekiCourt(Nanchou)
+	fmt.Println(name, year)
+	name, _, year = t.WarekiCourt(Hokuchou)
+	fmt.Println(name, year)
+	// Output:
+	// 興国 1
+	// 暦応 3
 }
 
 func ExampleJpTime_Eto() {
@@ -120,12 +210,139 @@ func ExampleJpTime_Sekki24() {
 }
 
 func ExampleJpTime_Holiday() {
-	t := NewJpTime(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.Local))
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	t := NewJpTime(time.Date(2016, time.January, 1, 0, 0, 0, 0, jst))
 	fmt.Println(t.Holiday())
 	// Output:
 	// true 元日
 }
 
+func ExampleHolidaysInYear() {
+	for _, h := range HolidaysInYear(2019)[4:9] {
+		fmt.Println(h.Date.Format("01/02"), h.Name, h.Kind)
+	}
+	// Output:
+	// 04/29 昭和の日 国民の祝日
+	// 04/30 国民の休日 国民の休日
+	// 05/01 即位の日 国民の祝日
+	// 05/02 国民の休日 国民の休日
+	// 05/03 憲法記念日 国民の祝日
+}
+
+func ExampleHolidaysBetween() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	from := NewJpTime(time.Date(2024, time.May, 1, 0, 0, 0, 0, jst))
+	to := NewJpTime(time.Date(2024, time.May, 31, 0, 0, 0, 0, jst))
+	for _, h := range HolidaysBetween(from, to) {
+		fmt.Println(h.Date.Format("01/02"), h.Name)
+	}
+	// Output:
+	// 05/03 憲法記念日
+	// 05/04 みどりの日
+	// 05/05 こどもの日
+	// 05/06 振替休日
+}
+
+func ExampleSetHolidayOverrides() {
+	defer SetHolidayOverrides(nil)
+	overrides, err := ReadHolidayOverridesYAML(strings.NewReader(`
+- date: 2026-06-10
+  name: 臨時の祝日
+- date: 2026-06-12
+  name: 臨時の祝日
+- date: 2026-11-03
+  remove: true
+`))
+	if err != nil {
+		panic(err)
+	}
+	if err := SetHolidayOverrides(overrides); err != nil {
+		panic(err)
+	}
+
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	from := NewJpTime(time.Date(2026, time.June, 1, 0, 0, 0, 0, jst))
+	to := NewJpTime(time.Date(2026, time.November, 30, 0, 0, 0, 0, jst))
+	for _, h := range HolidaysBetween(from, to) {
+		fmt.Println(h.Date.Format("01/02"), h.Name)
+	}
+	// Output:
+	// 06/10 臨時の祝日
+	// 06/11 国民の休日
+	// 06/12 臨時の祝日
+	// 07/20 海の日
+	// 08/11 山の日
+	// 09/21 敬老の日
+	// 09/22 国民の休日
+	// 09/23 秋分の日
+	// 10/12 スポーツの日
+	// 11/23 勤労感謝の日
+}
+
+func ExampleJpTime_NextHoliday() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	t := NewJpTime(time.Date(2019, time.April, 29, 0, 0, 0, 0, jst))
+	if h, ok := t.NextHoliday(); ok {
+		fmt.Println(h.Date.Format("2006-01-02"), h.Name)
+	}
+	if h, ok := t.PrevHoliday(); ok {
+		fmt.Println(h.Date.Format("2006-01-02"), h.Name)
+	}
+	// Output:
+	// 2019-04-30 国民の休日
+	// 2019-03-21 春分の日
+}
+
+func ExampleJpTime_AddBusinessDays() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	t := NewJpTime(time.Date(2019, time.April, 26, 0, 0, 0, 0, jst))
+	fmt.Println(t.AddBusinessDays(1).Format("2006-01-02"))
+	fmt.Println(BusinessDaysBetween(t, t.AddBusinessDays(3)))
+	// Output:
+	// 2019-05-07
+	// 3
+}
+
+func ExampleCalendar() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	c := NewCalendar()
+	c.CloseEveryYear(time.December, 31, "年末年始")
+	c.Close(NewJpTime(time.Date(2019, time.June, 3, 0, 0, 0, 0, jst)), "創立記念日")
+
+	t := NewJpTime(time.Date(2019, time.May, 31, 0, 0, 0, 0, jst))
+	fmt.Println(c.NextBusinessDay(t).Format("2006-01-02"))
+	fmt.Println(c.Closed(NewJpTime(time.Date(2019, time.December, 31, 0, 0, 0, 0, jst))))
+	// Output:
+	// 2019-06-04
+	// true 年末年始
+}
+
+func ExampleNewBankCalendar() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	c := NewBankCalendar()
+	t := NewJpTime(time.Date(2018, time.December, 31, 0, 0, 0, 0, jst))
+	fmt.Println(c.Closed(t))
+	fmt.Println(c.Adjust(t, Following).Format("2006-01-02"))
+	fmt.Println(c.Adjust(t, Preceding).Format("2006-01-02"))
+	// Output:
+	// true 年末年始
+	// 2019-01-04
+	// 2018-12-28
+}
+
+func ExampleTSE_Sessions() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	e := NewTSE()
+	for _, s := range e.Sessions(NewJpTime(time.Date(2024, time.November, 5, 0, 0, 0, 0, jst))) {
+		fmt.Println(s.Name, s.Open.Format("15:04"), s.Close.Format("15:04"))
+	}
+	fmt.Println(e.Daihakkai(2025).Format("2006-01-02"))
+	// Output:
+	// 前場 09:00 11:30
+	// 後場 12:30 15:30
+	// 2025-01-06
+}
+
 func ExampleJpTime_JpFormat() {
 	t := NewJpTime(time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local))
 	fmt.Println(t.JpFormat(ISO8601))
@@ -148,3 +365,53 @@ func ExampleJpTime_JpFormat() {
 	// （月）
 	// 月曜日
 }
+
+func ExampleJpTime_JpFormat_gannen() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	t := NewJpTime(time.Date(2019, time.May, 1, 0, 0, 0, 0, jst))
+	fmt.Println(t.JpFormat(WarekiDate))
+	fmt.Println(t.JpFormat(WarekiDate, Gannen))
+	fmt.Println(t.JpFormat(WarekiKanjiDate, Gannen))
+	fmt.Println(t.JpFormat(WarekiDate, Gannen, Zenkaku))
+	// Output:
+	// 令和1年5月1日
+	// 令和元年5月1日
+	// 令和元年五月一日
+	// 令和元年５月１日
+}
+
+func ExampleJpTime_JpFormat_layout() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	t := NewJpTime(time.Date(2020, time.January, 2, 15, 4, 5, 0, jst))
+	fmt.Println(t.JpFormat("平成18年1月2日（月）15時"))
+	fmt.Println(t.JpFormat("H18.1.2 15:04"))
+	fmt.Println(t.JpFormat("戌年 睦月"))
+	fmt.Println(t.JpFormat("平成元年 'Happy New Year'"))
+	// Output:
+	// 令和2年1月2日（木）15時
+	// R2.1.2 15:04
+	// 子年 睦月
+	// 令和2年 Happy New Year
+}
+
+func ExampleJpParse() {
+	t, err := JpParse(WarekiKanjiDate, "令和元年五月一日")
+	fmt.Println(t.Format("2006-01-02"), err)
+	_, err = JpParse(WarekiDate, "平成32年1月2日")
+	fmt.Println(err)
+	// Output:
+	// 2019-05-01 <nil>
+	// jptime: parsing "平成32年1月2日" as "平成18年1月2日": 平成32年1月2日 does not exist in 平成
+}
+
+func ExampleParseAny() {
+	for _, s := range []string{"平成１８年１月２日（月）", "H18.1.2", "令和元年五月一日", "2006/01/02"} {
+		t, err := ParseAny(s)
+		fmt.Println(t.Format("2006-01-02"), err)
+	}
+	// Output:
+	// 2006-01-02 <nil>
+	// 2006-01-02 <nil>
+	// 2019-05-01 <nil>
+	// 2006-01-02 <nil>
+}
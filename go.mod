@@ -0,0 +1,8 @@
+module github.com/jackpopper/jptime
+
+go 1.18
+
+require (
+	golang.org/x/text v0.14.0
+	gopkg.in/yaml.v3 v3.0.1
+)
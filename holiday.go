@@ -0,0 +1,344 @@
+package jptime
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// A holidayRule defines a 国民の祝日 and the period the rule is in force.
+// The date is given by one of day (固定日), week and weekday (第n何曜日) or sekki (春分・秋分).
+// from and to are YYYYMMDD, to is 0 while the rule is in force.
+// A one-off holiday has the same from and to.
+type holidayRule struct {
+	name    string
+	month   time.Month
+	day     int
+	week    int
+	weekday time.Weekday
+	sekki   string
+	from    int
+	to      int
+}
+
+// 国民の祝日に関する法律、皇室の儀式の行われる日を休日とする法律、
+// 東京オリンピック・パラリンピック競技大会特別措置法による祝日.
+var holidayRules = [...]holidayRule{
+	{name: "元日", month: time.January, day: 1, from: 19480720},
+	{name: "成人の日", month: time.January, day: 15, from: 19480720, to: 19991231},
+	{name: "成人の日", month: time.January, week: 2, weekday: time.Monday, from: 20000101},
+	{name: "建国記念の日", month: time.February, day: 11, from: 19660625},
+	{name: "天皇誕生日", month: time.February, day: 23, from: 20190501},
+	{name: "昭和天皇の大喪の礼", month: time.February, day: 24, from: 19890224, to: 19890224},
+	{name: "春分の日", month: time.March, sekki: "春分", from: 19480720},
+	{name: "天皇誕生日", month: time.April, day: 29, from: 19480720, to: 19890216},
+	{name: "みどりの日", month: time.April, day: 29, from: 19890217, to: 20061231},
+	{name: "皇太子明仁親王の結婚の儀", month: time.April, day: 10, from: 19590410, to: 19590410},
+	{name: "昭和の日", month: time.April, day: 29, from: 20070101},
+	{name: "憲法記念日", month: time.May, day: 3, from: 19480720},
+	{name: "即位の日", month: time.May, day: 1, from: 20190501, to: 20190501},
+	{name: "みどりの日", month: time.May, day: 4, from: 20070101},
+	{name: "こどもの日", month: time.May, day: 5, from: 19480720},
+	{name: "皇太子徳仁親王の結婚の儀", month: time.June, day: 9, from: 19930609, to: 19930609},
+	{name: "海の日", month: time.July, day: 20, from: 19960101, to: 20021231},
+	{name: "海の日", month: time.July, week: 3, weekday: time.Monday, from: 20030101, to: 20191231},
+	{name: "海の日", month: time.July, day: 23, from: 20200723, to: 20200723},
+	{name: "海の日", month: time.July, day: 22, from: 20210722, to: 20210722},
+	{name: "海の日", month: time.July, week: 3, weekday: time.Monday, from: 20220101},
+	{name: "スポーツの日", month: time.July, day: 24, from: 20200724, to: 20200724},
+	{name: "スポーツの日", month: time.July, day: 23, from: 20210723, to: 20210723},
+	{name: "山の日", month: time.August, day: 11, from: 20160101, to: 20191231},
+	{name: "山の日", month: time.August, day: 10, from: 20200810, to: 20200810},
+	{name: "山の日", month: time.August, day: 8, from: 20210808, to: 20210808},
+	{name: "山の日", month: time.August, day: 11, from: 20220101},
+	{name: "敬老の日", month: time.September, day: 15, from: 19660625, to: 20021231},
+	{name: "敬老の日", month: time.September, week: 3, weekday: time.Monday, from: 20030101},
+	{name: "秋分の日", month: time.September, sekki: "秋分", from: 19480720},
+	{name: "体育の日", month: time.October, day: 10, from: 19660625, to: 19991231},
+	{name: "体育の日", month: time.October, week: 2, weekday: time.Monday, from: 20000101, to: 20191231},
+	{name: "スポーツの日", month: time.October, week: 2, weekday: time.Monday, from: 20220101},
+	{name: "即位礼正殿の儀", month: time.October, day: 22, from: 20191022, to: 20191022},
+	{name: "文化の日", month: time.November, day: 3, from: 19480720},
+	{name: "即位礼正殿の儀", month: time.November, day: 12, from: 19901112, to: 19901112},
+	{name: "勤労感謝の日", month: time.November, day: 23, from: 19480720},
+	{name: "天皇誕生日", month: time.December, day: 23, from: 19890217, to: 20190430},
+}
+
+// A HolidayKind specifies the kind of a holiday.
+type HolidayKind int
+
+// These are the kinds of holidays.
+const (
+	Shukujitsu HolidayKind = iota // 国民の祝日
+	Furikae                       // 振替休日
+	Kokumin                       // 国民の休日
+)
+
+var holidayKinds = [...]string{
+	"国民の祝日",
+	"振替休日",
+	"国民の休日",
+}
+
+// String returns the japanese name of the kind.
+func (k HolidayKind) String() string { return holidayKinds[k] }
+
+// A HolidayEntry is a holiday on a date.
+type HolidayEntry struct {
+	Date JpTime
+	Name string
+	Kind HolidayKind
+}
+
+// The first year in which holidays are searched by NextHoliday and PrevHoliday,
+// and the years for which the holiday tables are kept.
+// 春分の日・秋分の日 are not computed from 2100.
+const (
+	holidayFirstYear = 1948
+	holidayLastYear  = 2099
+)
+
+// 振替休日・国民の休日の施行日 (YYYYMMDD).
+const (
+	furikaeFrom      = 19730412 // 日曜日の祝日の翌日
+	furikaeChainFrom = 20070101 // 日曜日の祝日以後の最初の祝日でない日
+	kokuminFrom      = 19851227 // 祝日に挟まれた日
+)
+
+// dayOf returns the day of the month of r in year, or 0.
+func (r holidayRule) dayOf(year int) int {
+	switch {
+	case r.week > 0:
+		first := time.Date(year, r.month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+		return 1 + (int(r.weekday-first)+7)%7 + (r.week-1)*7
+	case r.sekki != "":
+		// 2100年以降の二十四節気は未対応
+		if year >= 2100 {
+			return 0
+		}
+		for _, s := range sekki24 {
+			if s.name == r.sekki {
+				return s.day(year)
+			}
+		}
+		return 0
+	}
+	return r.day
+}
+
+// inForce reports whether r is in force on ymd.
+func (r holidayRule) inForce(ymd int) bool {
+	return r.from <= ymd && (r.to == 0 || ymd <= r.to)
+}
+
+func (t JpTime) ymd() int {
+	return t.Year()*10000 + int(t.Month())*100 + t.Day()
+}
+
+// Holiday returns 祝日名
+func (t JpTime) Holiday() (bool, string) {
+	if h, ok := t.holidayEntry(); ok {
+		return true, h.Name
+	}
+	return false, ""
+}
+
+// A holidayTable is the holidays of a year indexed by date.
+type holidayTable struct {
+	entries []HolidayEntry
+	index   [12][31]uint8 // entries の添字+1
+}
+
+// 1948〜2099年の年ごとの祝日表. 初めて参照されたときに作る.
+// SetHolidayOverrides で祝日の上書きを変更すると作り直す.
+var (
+	holidayTablesMu  sync.RWMutex
+	holidayTables    = make(map[int]*holidayTable)
+	holidayOverrides map[int]holidayOverride // YYYYMMDD
+	holidayGen       int
+)
+
+func holidayTableOf(year int) *holidayTable {
+	if year < holidayFirstYear || year > holidayLastYear {
+		// 範囲外の年は上書きが無いので保持せずに毎回求める
+		return newHolidayTable(year, nil)
+	}
+	for {
+		holidayTablesMu.RLock()
+		table, ok := holidayTables[year]
+		overrides, gen := holidayOverrides, holidayGen
+		holidayTablesMu.RUnlock()
+		if ok {
+			return table
+		}
+
+		table = newHolidayTable(year, overrides)
+		holidayTablesMu.Lock()
+		if gen != holidayGen {
+			// 作成中に上書きが変更された
+			holidayTablesMu.Unlock()
+			continue
+		}
+		if cached, ok := holidayTables[year]; ok {
+			table = cached
+		} else {
+			holidayTables[year] = table
+		}
+		holidayTablesMu.Unlock()
+		return table
+	}
+}
+
+func newHolidayTable(year int, overrides map[int]holidayOverride) *holidayTable {
+	table := &holidayTable{entries: holidaysInYear(year, overrides)}
+	for i, h := range table.entries {
+		table.index[h.Date.Month()-1][h.Date.Day()-1] = uint8(i + 1)
+	}
+	return table
+}
+
+func (t JpTime) holidayEntry() (HolidayEntry, bool) {
+	year, month, day := t.Date()
+	if year < holidayFirstYear {
+		return HolidayEntry{}, false
+	}
+	table := holidayTableOf(year)
+	if i := table.index[month-1][day-1]; i > 0 {
+		return table.entries[i-1], true
+	}
+	return HolidayEntry{}, false
+}
+
+// HolidaysInYear returns the holidays in year in date order.
+func HolidaysInYear(year int) []HolidayEntry {
+	return append([]HolidayEntry(nil), holidayTableOf(year).entries...)
+}
+
+// shukujitsuInYear computes the 国民の祝日 in year from holidayRules and overrides.
+func shukujitsuInYear(year int, overrides map[int]holidayOverride) []HolidayEntry {
+	var shukujitsu []HolidayEntry
+	for _, r := range holidayRules {
+		day := r.dayOf(year)
+		ymd := year*10000 + int(r.month)*100 + day
+		if day == 0 || !r.inForce(ymd) {
+			continue
+		}
+		if _, ok := overrides[ymd]; ok {
+			continue
+		}
+		shukujitsu = append(shukujitsu, HolidayEntry{NewJpTime(time.Date(year, r.month, day, 0, 0, 0, 0, jst)), r.name, Shukujitsu})
+	}
+	for ymd, o := range overrides {
+		if ymd/10000 == year && !o.remove {
+			shukujitsu = append(shukujitsu, HolidayEntry{NewJpTime(time.Date(year, time.Month(ymd/100%100), ymd%100, 0, 0, 0, 0, jst)), o.name, Shukujitsu})
+		}
+	}
+	sort.Slice(shukujitsu, func(i, j int) bool { return shukujitsu[i].Date.ymd() < shukujitsu[j].Date.ymd() })
+	return shukujitsu
+}
+
+// holidaysInYear computes the holidays in year from holidayRules and overrides.
+func holidaysInYear(year int, overrides map[int]holidayOverride) []HolidayEntry {
+	// 年をまたぐ振替休日・国民の休日のために前後の年の祝日も見る
+	var shukujitsu []HolidayEntry
+	for y := year - 1; y <= year+1; y++ {
+		shukujitsu = append(shukujitsu, shukujitsuInYear(y, overrides)...)
+	}
+
+	isShukujitsu := func(t JpTime) bool {
+		ymd := t.ymd()
+		for _, h := range shukujitsu {
+			if h.Date.ymd() == ymd {
+				return true
+			}
+		}
+		return false
+	}
+
+	holidays := make([]HolidayEntry, 0, len(shukujitsu)+4)
+	for _, h := range shukujitsu {
+		if h.Date.Year() == year {
+			holidays = append(holidays, h)
+		}
+		next := NewJpTime(h.Date.AddDate(0, 0, 1))
+
+		// 振替休日
+		if h.Date.Weekday() == time.Sunday && next.ymd() >= furikaeFrom {
+			t := next
+			for t.ymd() >= furikaeChainFrom && isShukujitsu(t) {
+				t = NewJpTime(t.AddDate(0, 0, 1))
+			}
+			if !isShukujitsu(t) && t.Year() == year {
+				holidays = append(holidays, HolidayEntry{t, "振替休日", Furikae})
+			}
+		}
+
+		// 国民の休日
+		if next.ymd() >= kokuminFrom && next.Year() == year && next.Weekday() != time.Sunday && !isShukujitsu(next) {
+			if after := NewJpTime(next.AddDate(0, 0, 1)); isShukujitsu(after) {
+				holidays = append(holidays, HolidayEntry{next, "国民の休日", Kokumin})
+			}
+		}
+	}
+	sort.SliceStable(holidays, func(i, j int) bool { return holidays[i].Date.ymd() < holidays[j].Date.ymd() })
+
+	// 振替休日と国民の休日が重なる場合は振替休日
+	uniq := holidays[:0]
+	for _, h := range holidays {
+		if n := len(uniq); n > 0 && uniq[n-1].Date.ymd() == h.Date.ymd() {
+			continue
+		}
+		if o, ok := overrides[h.Date.ymd()]; ok && o.remove {
+			continue
+		}
+		uniq = append(uniq, h)
+	}
+	return uniq
+}
+
+// HolidaysBetween returns the holidays from from to to inclusive in date order.
+func HolidaysBetween(from, to JpTime) []HolidayEntry {
+	first, last := from.ymd(), to.ymd()
+	var holidays []HolidayEntry
+	for year := from.Year(); year <= to.Year(); year++ {
+		for _, h := range holidayTableOf(year).entries {
+			if ymd := h.Date.ymd(); ymd >= first && ymd <= last {
+				holidays = append(holidays, h)
+			}
+		}
+	}
+	return holidays
+}
+
+// NextHoliday returns the first holiday after the date of t.
+// Holidays after 2099 are those of the current rules, as reported by Holiday.
+// It always reports true, since every year has 元日.
+func (t JpTime) NextHoliday() (HolidayEntry, bool) {
+	ymd := t.ymd()
+	year := t.Year()
+	if year < holidayFirstYear {
+		year = holidayFirstYear
+	}
+	for ; ; year++ {
+		for _, h := range holidayTableOf(year).entries {
+			if h.Date.ymd() > ymd {
+				return h, true
+			}
+		}
+	}
+}
+
+// PrevHoliday returns the last holiday before the date of t.
+// It reports false if t is before the first holiday in 1948.
+func (t JpTime) PrevHoliday() (HolidayEntry, bool) {
+	ymd := t.ymd()
+	for year := t.Year(); year >= holidayFirstYear; year-- {
+		holidays := holidayTableOf(year).entries
+		for i := len(holidays) - 1; i >= 0; i-- {
+			if holidays[i].Date.ymd() < ymd {
+				return holidays[i], true
+			}
+		}
+	}
+	return HolidayEntry{}, false
+}
@@ -0,0 +1,336 @@
+package jptime
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+type holidayRuleDayTest struct {
+	rule holidayRule
+	year int
+	day  int
+}
+
+var holidayruledaytests = []holidayRuleDayTest{
+	{holidayRule{month: time.November, day: 3}, 2016, 3},
+	{holidayRule{month: time.January, week: 2, weekday: time.Monday}, 2016, 11},
+	{holidayRule{month: time.January, week: 2, weekday: time.Monday}, 2018, 8},
+	{holidayRule{month: time.July, week: 3, weekday: time.Monday}, 2016, 18},
+	{holidayRule{month: time.October, week: 1, weekday: time.Sunday}, 2016, 2},
+	{holidayRule{month: time.March, sekki: "春分"}, 2016, 20},
+	{holidayRule{month: time.September, sekki: "秋分"}, 2016, 22},
+	{holidayRule{month: time.September, sekki: "秋分"}, 2100, 0},
+}
+
+func TestHolidayRule_DayOf(t *testing.T) {
+	for _, test := range holidayruledaytests {
+		if day := test.rule.dayOf(test.year); day != test.day {
+			t.Errorf("holidayRule.dayOf %v %d = %d, want %d", test.rule, test.year, day, test.day)
+		}
+	}
+}
+
+func TestHolidayRule_InForce(t *testing.T) {
+	r := holidayRule{from: 19890217, to: 20061231}
+	for ymd, want := range map[int]bool{
+		19890216: false,
+		19890217: true,
+		20061231: true,
+		20070101: false,
+	} {
+		if got := r.inForce(ymd); got != want {
+			t.Errorf("holidayRule.inForce %d = %v, want %v", ymd, got, want)
+		}
+	}
+	if r := (holidayRule{from: 20070101}); !r.inForce(99991231) {
+		t.Errorf("holidayRule.inForce without to = false")
+	}
+}
+
+var holidayenforcementtests = []JpTimeTest{
+	{time.Date(1948, time.May, 5, 0, 0, 0, 0, jst), ""},
+	{time.Date(1948, time.September, 23, 0, 0, 0, 0, jst), "秋分の日"},
+	{time.Date(1966, time.February, 11, 0, 0, 0, 0, jst), ""},
+	{time.Date(1967, time.February, 11, 0, 0, 0, 0, jst), "建国記念の日"},
+	{time.Date(1966, time.September, 15, 0, 0, 0, 0, jst), "敬老の日"},
+	{time.Date(1973, time.February, 12, 0, 0, 0, 0, jst), ""},
+	{time.Date(1973, time.April, 30, 0, 0, 0, 0, jst), "振替休日"},
+	{time.Date(1995, time.July, 20, 0, 0, 0, 0, jst), ""},
+	{time.Date(1996, time.July, 20, 0, 0, 0, 0, jst), "海の日"},
+	{time.Date(1999, time.October, 10, 0, 0, 0, 0, jst), "体育の日"},
+	{time.Date(2000, time.October, 9, 0, 0, 0, 0, jst), "体育の日"},
+	{time.Date(2000, time.October, 10, 0, 0, 0, 0, jst), ""},
+	{time.Date(2006, time.May, 4, 0, 0, 0, 0, jst), "国民の休日"},
+	{time.Date(2007, time.May, 4, 0, 0, 0, 0, jst), "みどりの日"},
+	{time.Date(2008, time.May, 6, 0, 0, 0, 0, jst), "振替休日"},
+	{time.Date(1959, time.April, 10, 0, 0, 0, 0, jst), "皇太子明仁親王の結婚の儀"},
+	{time.Date(1960, time.April, 10, 0, 0, 0, 0, jst), ""},
+	{time.Date(1989, time.February, 24, 0, 0, 0, 0, jst), "昭和天皇の大喪の礼"},
+	{time.Date(1990, time.November, 12, 0, 0, 0, 0, jst), "即位礼正殿の儀"},
+	{time.Date(1991, time.November, 12, 0, 0, 0, 0, jst), ""},
+	{time.Date(1993, time.June, 9, 0, 0, 0, 0, jst), "皇太子徳仁親王の結婚の儀"},
+	{time.Date(2019, time.October, 22, 0, 0, 0, 0, jst), "即位礼正殿の儀"},
+}
+
+func TestJpTime_HolidayEnforcement(t *testing.T) {
+	for _, test := range holidayenforcementtests {
+		_, name := NewJpTime(test.time).Holiday()
+		if name != test.str {
+			t.Errorf("JpTime_Holiday %v : %v = %v", test.time, test.str, name)
+		}
+	}
+}
+
+func TestHolidaysInYear(t *testing.T) {
+	for year := 1947; year <= 2100; year++ {
+		holidays := HolidaysInYear(year)
+		k := 0
+		for tm := time.Date(year, time.January, 1, 0, 0, 0, 0, jst); tm.Year() == year; tm = tm.AddDate(0, 0, 1) {
+			isHoliday, name := referenceHoliday(NewJpTime(tm))
+			if !isHoliday {
+				continue
+			}
+			if k >= len(holidays) {
+				t.Fatalf("HolidaysInYear(%d) is missing %v %v", year, tm.Format("2006-01-02"), name)
+			}
+			if h := holidays[k]; h.Date.Format("2006-01-02") != tm.Format("2006-01-02") || h.Name != name {
+				t.Fatalf("HolidaysInYear(%d)[%d] = %v %v, want %v %v", year, k, h.Date.Format("2006-01-02"), h.Name, tm.Format("2006-01-02"), name)
+			}
+			k++
+		}
+		if k != len(holidays) {
+			t.Errorf("HolidaysInYear(%d) has %d holidays, want %d", year, len(holidays), k)
+		}
+	}
+}
+
+var holidaykindtests = []struct {
+	time time.Time
+	kind HolidayKind
+}{
+	{time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), Shukujitsu},
+	{time.Date(2019, time.May, 2, 0, 0, 0, 0, jst), Kokumin},
+	{time.Date(2019, time.May, 6, 0, 0, 0, 0, jst), Furikae},
+}
+
+func TestHolidaysInYear_Kind(t *testing.T) {
+	holidays := HolidaysInYear(2019)
+	for _, test := range holidaykindtests {
+		for _, h := range holidays {
+			if h.Date.Equal(test.time) && h.Kind != test.kind {
+				t.Errorf("HolidaysInYear %v kind = %v, want %v", test.time, h.Kind, test.kind)
+			}
+		}
+	}
+}
+
+func TestHolidaysBetween(t *testing.T) {
+	from := NewJpTime(time.Date(2018, time.December, 23, 12, 0, 0, 0, jst))
+	to := NewJpTime(time.Date(2019, time.January, 14, 0, 0, 0, 0, jst))
+	var got []string
+	for _, h := range HolidaysBetween(from, to) {
+		got = append(got, h.Date.Format("2006-01-02")+" "+h.Name)
+	}
+	want := []string{"2018-12-23 天皇誕生日", "2018-12-24 振替休日", "2019-01-01 元日", "2019-01-14 成人の日"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("HolidaysBetween = %v, want %v", got, want)
+	}
+	if h := HolidaysBetween(to, from); len(h) != 0 {
+		t.Errorf("HolidaysBetween reversed = %v", h)
+	}
+}
+
+var nextholidaytests = []struct {
+	time time.Time
+	next string
+	prev string
+}{
+	{time.Date(2019, time.April, 29, 0, 0, 0, 0, jst), "2019-04-30 国民の休日", "2019-03-21 春分の日"},
+	{time.Date(2019, time.April, 30, 23, 0, 0, 0, jst), "2019-05-01 即位の日", "2019-04-29 昭和の日"},
+	{time.Date(2018, time.December, 25, 0, 0, 0, 0, jst), "2019-01-01 元日", "2018-12-24 振替休日"},
+	{time.Date(1900, time.January, 1, 0, 0, 0, 0, jst), "1948-09-23 秋分の日", ""},
+	{time.Date(1948, time.September, 23, 0, 0, 0, 0, jst), "1948-11-03 文化の日", ""},
+	{time.Date(2099, time.December, 1, 0, 0, 0, 0, jst), "2100-01-01 元日", "2099-11-23 勤労感謝の日"},
+	{time.Date(2100, time.January, 2, 0, 0, 0, 0, jst), "2100-01-11 成人の日", "2100-01-01 元日"},
+}
+
+func TestJpTime_NextHoliday(t *testing.T) {
+	format := func(h HolidayEntry, ok bool) string {
+		if !ok {
+			return ""
+		}
+		return h.Date.Format("2006-01-02") + " " + h.Name
+	}
+	for _, test := range nextholidaytests {
+		jpt := NewJpTime(test.time)
+		if next := format(jpt.NextHoliday()); next != test.next {
+			t.Errorf("JpTime_NextHoliday %v = %q, want %q", test.time, next, test.next)
+		}
+		if prev := format(jpt.PrevHoliday()); prev != test.prev {
+			t.Errorf("JpTime_PrevHoliday %v = %q, want %q", test.time, prev, test.prev)
+		}
+	}
+}
+
+func TestJpTime_NextHolidayAgrees(t *testing.T) {
+	for tm := time.Date(2098, time.December, 1, 0, 0, 0, 0, jst); tm.Year() <= 2101; tm = tm.AddDate(0, 0, 1) {
+		jpt := NewJpTime(tm)
+		next, _ := jpt.NextHoliday()
+		prev, _ := jpt.PrevHoliday()
+		for d := NewJpTime(tm.AddDate(0, 0, 1)); ; d = NewJpTime(d.AddDate(0, 0, 1)) {
+			if ok, _ := d.Holiday(); ok {
+				if d.ymd() != next.Date.ymd() {
+					t.Fatalf("JpTime_NextHoliday %v = %v, Holiday %v", tm.Format("2006-01-02"), next.Date.Format("2006-01-02"), d.Format("2006-01-02"))
+				}
+				break
+			}
+		}
+		for d := NewJpTime(tm.AddDate(0, 0, -1)); ; d = NewJpTime(d.AddDate(0, 0, -1)) {
+			if ok, _ := d.Holiday(); ok {
+				if d.ymd() != prev.Date.ymd() {
+					t.Fatalf("JpTime_PrevHoliday %v = %v, Holiday %v", tm.Format("2006-01-02"), prev.Date.Format("2006-01-02"), d.Format("2006-01-02"))
+				}
+				break
+			}
+		}
+	}
+}
+
+// referenceHoliday evaluates holidayRules day by day without the holiday table.
+func referenceHoliday(t JpTime) (bool, string) {
+	isHoliday, holidayName := referenceShukujitsu(t)
+	if !isHoliday {
+		isHoliday, holidayName = referenceFurikae(t)
+		if !isHoliday {
+			isHoliday, holidayName = referenceKokumin(t)
+		}
+	}
+	return isHoliday, holidayName
+}
+
+// 祝日名.
+func referenceShukujitsu(t JpTime) (bool, string) {
+	ymd := t.ymd()
+	for _, r := range holidayRules {
+		if r.month == t.Month() && r.inForce(ymd) && r.dayOf(t.Year()) == t.Day() {
+			return true, r.name
+		}
+	}
+	return false, ""
+}
+
+// 振替休日.
+func referenceFurikae(t JpTime) (bool, string) {
+	ymd := t.ymd()
+	if ymd < furikaeFrom || t.Weekday() == time.Sunday {
+		return false, ""
+	}
+
+	for i := 1; i <= 5; i++ {
+		prevday := NewJpTime(t.AddDate(0, 0, -i))
+		if isHoliday, _ := referenceShukujitsu(prevday); isHoliday {
+			if prevday.Weekday() == time.Sunday {
+				return true, "振替休日"
+			} else if ymd < furikaeChainFrom {
+				// 2007年までは前日のみチェック
+				break
+			}
+		} else {
+			break
+		}
+	}
+	return false, ""
+}
+
+// 国民の休日.
+func referenceKokumin(t JpTime) (bool, string) {
+	if t.ymd() < kokuminFrom || t.Weekday() == time.Sunday {
+		return false, ""
+	}
+
+	if yIsHoliday, _ := referenceShukujitsu(NewJpTime(t.AddDate(0, 0, -1))); yIsHoliday {
+		if tIsholiday, _ := referenceShukujitsu(NewJpTime(t.AddDate(0, 0, 1))); tIsholiday {
+			return true, "国民の休日"
+		}
+	}
+	return false, ""
+}
+
+func TestJpTime_HolidayAllocs(t *testing.T) {
+	jpt := NewJpTime(time.Date(2019, time.May, 6, 0, 0, 0, 0, jst))
+	jpt.Holiday()
+	if allocs := testing.AllocsPerRun(100, func() { jpt.Holiday() }); allocs != 0 {
+		t.Errorf("JpTime_Holiday allocs = %v, want 0", allocs)
+	}
+}
+
+func TestHolidayTable_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for year := 2090; year < 2100; year++ {
+				NewJpTime(time.Date(year, time.January, 1+i, 0, 0, 0, 0, jst)).Holiday()
+				HolidaysInYear(year)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestHolidayTable_Range(t *testing.T) {
+	for _, year := range [...]int{1900, 1947, 2100, 3000} {
+		NewJpTime(time.Date(year, time.January, 1, 0, 0, 0, 0, jst)).Holiday()
+		HolidaysInYear(year)
+	}
+	if _, name := NewJpTime(time.Date(3000, time.January, 1, 0, 0, 0, 0, jst)).Holiday(); name != "元日" {
+		t.Errorf("JpTime_Holiday 3000-01-01 = %q, want 元日", name)
+	}
+	holidayTablesMu.RLock()
+	defer holidayTablesMu.RUnlock()
+	for year := range holidayTables {
+		if year < holidayFirstYear || year > holidayLastYear {
+			t.Errorf("holiday table of %d is cached", year)
+		}
+	}
+}
+
+func TestHolidaysInYear_Copy(t *testing.T) {
+	HolidaysInYear(2019)[0].Name = "変更"
+	if _, name := NewJpTime(time.Date(2019, time.January, 1, 0, 0, 0, 0, jst)).Holiday(); name != "元日" {
+		t.Errorf("HolidaysInYear shares the holiday table: %v", name)
+	}
+}
+
+var benchmarkBool bool
+
+func BenchmarkJpTime_HolidayTable(b *testing.B) {
+	days := make([]JpTime, 366)
+	for i := range days {
+		days[i] = NewJpTime(time.Date(2019, time.January, 1+i, 0, 0, 0, 0, jst))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkBool, _ = days[i%len(days)].Holiday()
+	}
+}
+
+func BenchmarkJpTime_HolidayReference(b *testing.B) {
+	days := make([]JpTime, 366)
+	for i := range days {
+		days[i] = NewJpTime(time.Date(2019, time.January, 1+i, 0, 0, 0, 0, jst))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkBool, _ = referenceHoliday(days[i%len(days)])
+	}
+}
+
+func BenchmarkHolidaysInYear(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		HolidaysInYear(2019)
+	}
+}
@@ -1,5 +1,7 @@
 package jptime
 
+import "strings"
+
 // These are predefined layouts for use in JpTime.JpFormat
 const (
 	ISO8601           = "2006-01-02T15:04:05-07:00"
@@ -13,39 +15,293 @@ const (
 	JpWeekdayString   = "月曜日"
 )
 
+// A FormatOption specifies an option for JpTime.JpFormat and the numeral formatters.
+type FormatOption int
+
+// These are options for JpTime.JpFormat and FmtIntKanjiMeisuu.
+const (
+	Gannen    FormatOption = 1 << iota // 元号の1年を元年と表記
+	Zenkaku                            // 数字を全角で表記
+	Issen                              // 漢数字の千を一千と表記
+	Daiji                              // 漢数字を大字（壱・弐・参・拾）で表記
+	Kyuudaiji                          // 漢数字を旧字体の大字（壱・弐・参・肆・伍・陸・漆・捌・玖・拾・佰・阡・萬）で表記
+	Fu                                 // 負の漢数字をマイナスではなく負と表記
+)
+
 // JpFormat returns a textual representation for japanese format.
-func (t JpTime) JpFormat(layout string) string {
+// Layouts other than the predefined ones are composed of the following tokens,
+// which are the japanese representations of the reference time
+// Mon Jan 2 15:04:05 2006 (平成18年), mixed freely with the tokens of time.Format.
+//
+//	平成        元号
+//	平          元号の1文字目
+//	H           元号の略号
+//	18          元号の年
+//	元年        元号の年、1年は元年
+//	十八年      元号の年（漢数字）
+//	二〇〇六年  年（漢数字）
+//	一月        月（漢数字）
+//	二日        日（漢数字）
+//	十五時      時（漢数字）、一五時も可
+//	四分        分（漢数字）
+//	五秒        秒（漢数字）
+//	月曜日      曜日、月曜・（月）・(月)も可
+//	睦月        月（旧暦）
+//	戌          干支
+//
+// 18 is not a token when it is a part of a longer number such as .000000018.
+// Text enclosed in single quotes is copied as is, without replacing the tokens,
+// and two single quotes are a single quote: "'平日' 1/2" formats as "平日 1/2".
+// 六曜 is not supported, as 旧暦 dates are not computed.
+//
+// For example "平成18年1月2日（月）15時" formats as "令和2年1月2日（木）15時".
+// opts are applied to the era year and the numerals of the result.
+// With Daiji or Kyuudaiji the year of KanjiDate is written in 命数法, as 弐千弐拾年.
+func (t JpTime) JpFormat(layout string, opts ...FormatOption) string {
+	var o FormatOption
+	for _, opt := range opts {
+		o |= opt
+	}
+	if o&Zenkaku != 0 {
+		return zenkaku(t.JpFormat(layout, o&^Zenkaku))
+	}
+
 	switch layout {
 	case JISX0301:
 		_, initial, year := t.Wareki()
 		if len(initial) == 1 {
-			return initial + FmtInt(year) + t.Format(".01.02")
+			return initial + fmtYear2(year) + t.Format(".01.02")
 		}
 	case JISX0301JP:
 		name, initial, year := t.Wareki()
 		if len(initial) == 1 {
 			buf := []rune(name)
-			return string(buf[0]) + FmtInt(year) + t.Format(".01.02")
+			return string(buf[0]) + fmtYear2(year) + t.Format(".01.02")
 		}
 	case KanjiDate:
-		return t.JpYear().String() + t.JpMonth().String() + t.JpDay().String()
+		return o.kanjiYear(t) + o.kanji(int(t.Month()), "月") + o.kanji(t.Day(), "日")
 	case KanjiTime:
-		return t.JpHour().String() + t.JpMinute().String() + t.JpSecond().String()
+		return o.kanji(t.Hour(), "時") + o.kanji(t.Minute(), "分") + o.kanji(t.Second(), "秒")
 	case WarekiDate:
-		name, _, year := t.Wareki()
-		return name + FmtInt(year) + "年" + t.Format("1月2日")
+		e := t.Era()
+		return e.Name + o.fmtEraYear(e, false) + "年" + t.Format("1月2日")
 	case WarekiKanjiDate:
-		name, initial, year := t.Wareki()
-		if len(initial) == 1 {
-			return name + FmtIntKanjiMeisuu(year) + "年" + t.JpMonth().String() + t.JpDay().String()
+		if e := t.Era(); e.Wareki != Kigenzen && e.Wareki != Seireki {
+			return e.Name + o.fmtEraYear(e, true) + "年" + o.kanji(int(t.Month()), "月") + o.kanji(t.Day(), "日")
 		}
-		return t.JpYear().String() + t.JpMonth().String() + t.JpDay().String()
+		return o.kanjiYear(t) + o.kanji(int(t.Month()), "月") + o.kanji(t.Day(), "日")
 	case JpWeekdayBrackets:
 		return "（" + t.JpWeekday().String() + "）"
 	case JpWeekdayString:
 		return t.JpWeekday().String() + "曜日"
 	default:
-		return t.Format(layout)
+		return t.jpFormat(layout, o)
 	}
 	return ""
 }
+
+type jpLayoutToken struct {
+	token  string
+	format func(t JpTime, o FormatOption) string
+}
+
+// 最長一致のため長いものから並べる.
+var jpLayoutTokens = [...]jpLayoutToken{
+	{"二〇〇六年", func(t JpTime, o FormatOption) string { return o.kanjiYear(t) }},
+	{"十八年", func(t JpTime, o FormatOption) string { return o.fmtEraYear(t.Era(), true) + "年" }},
+	{"十五時", func(t JpTime, o FormatOption) string { return o.kanji(t.Hour(), "時") }},
+	{"一五時", func(t JpTime, o FormatOption) string { return o.kanji(t.Hour(), "時") }},
+	{"月曜日", func(t JpTime, o FormatOption) string { return t.JpWeekday().String() + "曜日" }},
+	{"（月）", func(t JpTime, o FormatOption) string { return "（" + t.JpWeekday().String() + "）" }},
+	{"(月)", func(t JpTime, o FormatOption) string { return "(" + t.JpWeekday().String() + ")" }},
+	{"月曜", func(t JpTime, o FormatOption) string { return t.JpWeekday().String() + "曜" }},
+	{"平成", func(t JpTime, o FormatOption) string { return t.Era().Name }},
+	{"元年", func(t JpTime, o FormatOption) string { return (o|Gannen).fmtEraYear(t.Era(), false) + "年" }},
+	{"一月", func(t JpTime, o FormatOption) string { return o.kanji(int(t.Month()), "月") }},
+	{"二日", func(t JpTime, o FormatOption) string { return o.kanji(t.Day(), "日") }},
+	{"四分", func(t JpTime, o FormatOption) string { return o.kanji(t.Minute(), "分") }},
+	{"五秒", func(t JpTime, o FormatOption) string { return o.kanji(t.Second(), "秒") }},
+	{"睦月", func(t JpTime, o FormatOption) string { return t.KyuurekiMonth() }},
+	{"18", func(t JpTime, o FormatOption) string { return o.fmtEraYear(t.Era(), false) }},
+	{"平", func(t JpTime, o FormatOption) string { return string([]rune(t.Era().Name)[0]) }},
+	{"戌", func(t JpTime, o FormatOption) string { return t.Eto() }},
+	{"H", func(t JpTime, o FormatOption) string { return t.Era().Initial }},
+}
+
+// jpFormat formats layout composed of japanese tokens, time.Format tokens and quoted text.
+func (t JpTime) jpFormat(layout string, o FormatOption) string {
+	buf := make([]byte, 0, len(layout)+10)
+	lit := 0
+	for i := 0; i < len(layout); {
+		if layout[i] == '\'' {
+			if lit < i {
+				buf = append(buf, t.Format(layout[lit:i])...)
+			}
+			buf, i = appendQuoted(buf, layout, i)
+			lit = i
+			continue
+		}
+		matched := false
+		for _, tok := range jpLayoutTokens {
+			if strings.HasPrefix(layout[i:], tok.token) && !inNumber(layout, i, len(tok.token)) {
+				if lit < i {
+					buf = append(buf, t.Format(layout[lit:i])...)
+				}
+				buf = append(buf, tok.format(t, o)...)
+				i += len(tok.token)
+				lit = i
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			i++
+		}
+	}
+	if lit < len(layout) {
+		buf = append(buf, t.Format(layout[lit:])...)
+	}
+	return string(buf)
+}
+
+// appendQuoted appends the text quoted at layout[i] and returns the index after it.
+// Two single quotes are a single quote, and a quote without the closing one lasts to the end of layout.
+func appendQuoted(buf []byte, layout string, i int) ([]byte, int) {
+	i++
+	if i < len(layout) && layout[i] == '\'' {
+		return append(buf, '\''), i + 1
+	}
+	for i < len(layout) {
+		if layout[i] == '\'' {
+			if i+1 < len(layout) && layout[i+1] == '\'' {
+				buf = append(buf, '\'')
+				i += 2
+				continue
+			}
+			return buf, i + 1
+		}
+		buf = append(buf, layout[i])
+		i++
+	}
+	return buf, i
+}
+
+// 数字のトークン layout[i:i+n] が長い数字の一部かどうか.
+func inNumber(layout string, i, n int) bool {
+	if !isDigit(layout[i]) {
+		return false
+	}
+	return i > 0 && isDigit(layout[i-1]) || i+n < len(layout) && isDigit(layout[i+n])
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// 数字を全角にする.
+func zenkaku(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r - '0' + '０'
+		}
+		return r
+	}, s)
+}
+
+// 数字を半角にする.
+func hankaku(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '０' && r <= '９' {
+			return r - '０' + '0'
+		}
+		return r
+	}, s)
+}
+
+var daijiNumerals = map[rune]rune{
+	'一': '壱',
+	'二': '弐',
+	'三': '参',
+	'十': '拾',
+}
+
+var kyuudaijiNumerals = map[rune]rune{
+	'一': '壱',
+	'二': '弐',
+	'三': '参',
+	'四': '肆',
+	'五': '伍',
+	'六': '陸',
+	'七': '漆',
+	'八': '捌',
+	'九': '玖',
+	'十': '拾',
+	'百': '佰',
+	'千': '阡',
+	'万': '萬',
+}
+
+// 漢数字（命数法）の月日時分秒. 大字の指定があれば大字で表記する.
+func (o FormatOption) kanji(i int, unit string) string {
+	return FmtIntKanjiMeisuu(i, o) + unit
+}
+
+// 漢数字の年. 大字では〇を使わず命数法で表記する.
+func (o FormatOption) kanjiYear(t JpTime) string {
+	if o&(Daiji|Kyuudaiji) == 0 {
+		return t.JpYear().String()
+	}
+	if year := t.Year(); year <= 0 {
+		return "紀元前" + FmtIntKanjiMeisuu(1-year, o) + "年"
+	}
+	return FmtIntKanjiMeisuu(t.Year(), o) + "年"
+}
+
+// 漢数字を大字にする.
+func (o FormatOption) daiji(s string) string {
+	if o&(Daiji|Kyuudaiji) == 0 {
+		return s
+	}
+	return strings.Map(o.daijiRune, s)
+}
+
+func (o FormatOption) daijiRune(r rune) rune {
+	numerals := daijiNumerals
+	switch {
+	case o&Kyuudaiji != 0:
+		numerals = kyuudaijiNumerals
+	case o&Daiji == 0:
+		return r
+	}
+	if d, ok := numerals[r]; ok {
+		return d
+	}
+	return r
+}
+
+// 負の漢数字の接頭辞.
+func (o FormatOption) minus() string {
+	if o&Fu != 0 {
+		return "負"
+	}
+	return "マイナス"
+}
+
+// 元号の年.
+func (o FormatOption) fmtEraYear(e Era, kanji bool) string {
+	if o&Gannen != 0 && e.Year == 1 && e.Wareki != Kigenzen && e.Wareki != Seireki {
+		return "元"
+	}
+	if kanji {
+		return FmtIntKanjiMeisuu(e.Year, o)
+	}
+	return FmtInt(e.Year)
+}
+
+// JIS X 0301 の年は2桁.
+func fmtYear2(year int) string {
+	if year < 10 {
+		return "0" + FmtInt(year)
+	}
+	return FmtInt(year)
+}
@@ -15,15 +15,39 @@ type JpFormatTest struct {
 var jpformatTests = []JpFormatTest{
 	{"ISO8601", ISO8601, time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local), "2006-01-02T15:04:05+09:00"},
 	{"JISX0301", JISX0301, time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local), "H18.01.02"},
-	{"JISX0301_OutOfRange", JISX0301, time.Date(1872, time.January, 2, 15, 4, 5, 0, time.Local), ""},
+	{"JISX0301_OutOfRange", JISX0301, time.Date(1867, time.January, 2, 15, 4, 5, 0, time.Local), ""},
+	{"JISX0301_Heisei1", JISX0301, time.Date(1989, time.January, 8, 0, 0, 0, 0, time.Local), "H01.01.08"},
+	{"JISX0301_Reiwa", JISX0301, time.Date(2019, time.May, 1, 0, 0, 0, 0, time.Local), "R01.05.01"},
 	{"JISX0301JP", JISX0301JP, time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local), "平18.01.02"},
 	{"KanjiDate", KanjiDate, time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local), "二〇〇六年一月二日"},
 	{"KanjiTime", KanjiTime, time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local), "十五時四分五秒"},
 	{"WarekiDate", WarekiDate, time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local), "平成18年1月2日"},
+	{"WarekiDate_Reiwa", WarekiDate, time.Date(2020, time.January, 2, 0, 0, 0, 0, time.Local), "令和2年1月2日"},
 	{"WarekiKanjiDate", WarekiKanjiDate, time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local), "平成十八年一月二日"},
-	{"WarekiKanjiDate2", WarekiKanjiDate, time.Date(1872, time.January, 2, 15, 4, 5, 0, time.Local), "一八七二年一月二日"},
+	{"WarekiKanjiDate2", WarekiKanjiDate, time.Date(600, time.January, 2, 15, 4, 5, 0, time.Local), "六〇〇年一月二日"},
+	{"WarekiKanjiDate_Tenpo", WarekiKanjiDate, time.Date(1843, time.March, 2, 15, 4, 5, 0, time.Local), "天保十四年三月二日"},
+	{"WarekiDate_Tenpo", WarekiDate, time.Date(1843, time.March, 2, 15, 4, 5, 0, time.Local), "天保14年3月2日"},
 	{"JpWeekdayBrackets", JpWeekdayBrackets, time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local), "（月）"},
 	{"JpWeekdayString", JpWeekdayString, time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local), "月曜日"},
+	{"Layout", "平成18年1月2日（月）15時", time.Date(2020, time.January, 2, 15, 4, 5, 0, jst), "令和2年1月2日（木）15時"},
+	{"Layout_Go", "H18 2006-01-02 15:04:05 Mon", time.Date(2006, time.January, 2, 15, 4, 5, 0, jst), "H18 2006-01-02 15:04:05 Mon"},
+	{"Layout_Initial", "H18/01/02", time.Date(1989, time.January, 7, 0, 0, 0, 0, jst), "S64/01/07"},
+	{"Layout_FirstLetter", "平18年", time.Date(1926, time.December, 25, 0, 0, 0, 0, jst), "昭1年"},
+	{"Layout_Kanji", "平成十八年一月二日 十五時四分五秒", time.Date(2016, time.December, 31, 23, 59, 0, 0, jst), "平成二十八年十二月三十一日 二十三時五十九分零秒"},
+	{"Layout_KanjiYear", "二〇〇六年一月二日(月)", time.Date(2016, time.December, 31, 0, 0, 0, 0, jst), "二〇一六年十二月三十一日(土)"},
+	{"Layout_KanjiTime", "一五時四分", time.Date(2016, time.December, 31, 9, 5, 0, 0, jst), "九時五分"},
+	{"Layout_Weekday", "1/2 月曜 Monday", time.Date(2016, time.December, 31, 0, 0, 0, 0, jst), "12/31 土曜 Saturday"},
+	{"Layout_Kyuureki", "睦月 戌年", time.Date(2016, time.March, 1, 0, 0, 0, 0, jst), "弥生 申年"},
+	{"Layout_Quote", "'Hello 平日' 2006", time.Date(2020, time.January, 2, 0, 0, 0, 0, jst), "Hello 平日 2020"},
+	{"Layout_QuoteGo", "'Mon' Mon", time.Date(2020, time.January, 2, 0, 0, 0, 0, jst), "Mon Thu"},
+	{"Layout_QuoteEscape", "H'H''s'", time.Date(2020, time.January, 2, 0, 0, 0, 0, jst), "RH's"},
+	{"Layout_QuoteSingle", "平''平", time.Date(2020, time.January, 2, 0, 0, 0, 0, jst), "令'令"},
+	{"Layout_QuoteUnterminated", "平成18年'（祝）", time.Date(2020, time.January, 2, 0, 0, 0, 0, jst), "令和2年（祝）"},
+	{"Layout_GannenToken", "平成元年1月", time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), "令和元年5月"},
+	{"Layout_GannenToken2", "平成元年1月", time.Date(2020, time.May, 1, 0, 0, 0, 0, jst), "令和2年5月"},
+	{"Layout_GannenTokenSeireki", "平成元年", time.Date(1, time.May, 1, 0, 0, 0, 0, jst), "西暦1年"},
+	{"Layout_Nanosecond", "H18 2006-01-02 15:04:05.000000018", time.Date(2020, time.January, 2, 15, 4, 5, 18, jst), "R2 2020-01-02 15:04:05.000000018"},
+	{"Layout_Gengo", "平成18年（2006年）", time.Date(1843, time.March, 2, 0, 0, 0, 0, jst), "天保14年（1843年）"},
 }
 
 func TestJpTime_JpFormat(t *testing.T) {
@@ -35,3 +59,47 @@ func TestJpTime_JpFormat(t *testing.T) {
 		}
 	}
 }
+
+type JpFormatOptionTest struct {
+	name   string
+	format string
+	opt    FormatOption
+	time   time.Time
+	result string
+}
+
+var jpformatOptionTests = []JpFormatOptionTest{
+	{"WarekiDate_Gannen", WarekiDate, Gannen, time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), "令和元年5月1日"},
+	{"WarekiDate_Gannen2", WarekiDate, Gannen, time.Date(2020, time.May, 1, 0, 0, 0, 0, jst), "令和2年5月1日"},
+	{"WarekiDate_GannenSeireki", WarekiDate, Gannen, time.Date(1, time.May, 1, 0, 0, 0, 0, jst), "西暦1年5月1日"},
+	{"WarekiKanjiDate_Gannen", WarekiKanjiDate, Gannen, time.Date(1989, time.January, 8, 0, 0, 0, 0, jst), "平成元年一月八日"},
+	{"WarekiKanjiDate_GannenGengo", WarekiKanjiDate, Gannen, time.Date(1860, time.May, 1, 0, 0, 0, 0, jst), "万延元年五月一日"},
+	{"Layout_Gannen", "平成18年（戌）", Gannen, time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), "令和元年（亥）"},
+	{"Layout_GannenKanji", "平成十八年一月", Gannen, time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), "令和元年五月"},
+	{"WarekiDate_Zenkaku", WarekiDate, Zenkaku, time.Date(2016, time.January, 2, 0, 0, 0, 0, jst), "平成２８年１月２日"},
+	{"WarekiDate_ZenkakuGannen", WarekiDate, Zenkaku | Gannen, time.Date(2019, time.May, 10, 0, 0, 0, 0, jst), "令和元年５月１０日"},
+	{"JISX0301JP_Zenkaku", JISX0301JP, Zenkaku, time.Date(2016, time.January, 2, 0, 0, 0, 0, jst), "平２８.０１.０２"},
+	{"ISO8601_Zenkaku", ISO8601, Zenkaku, time.Date(2016, time.January, 2, 0, 0, 0, 0, jst), "２０１６-０１-０２T００:００:００+０９:００"},
+	{"Layout_Zenkaku", "2006年1月2日（月）", Zenkaku, time.Date(2016, time.January, 2, 0, 0, 0, 0, jst), "２０１６年１月２日（土）"},
+	{"KanjiDate_Zenkaku", KanjiDate, Zenkaku, time.Date(2016, time.January, 2, 0, 0, 0, 0, jst), "二〇一六年一月二日"},
+	{"WarekiKanjiDate_Daiji", WarekiKanjiDate, Daiji, time.Date(2020, time.January, 10, 0, 0, 0, 0, jst), "令和弐年壱月壱拾日"},
+	{"WarekiKanjiDate_DaijiGannen", WarekiKanjiDate, Daiji | Gannen, time.Date(2019, time.December, 3, 0, 0, 0, 0, jst), "令和元年壱拾弐月参日"},
+	{"WarekiKanjiDate_Kyuudaiji", WarekiKanjiDate, Kyuudaiji, time.Date(1860, time.June, 9, 0, 0, 0, 0, jst), "万延壱年陸月玖日"},
+	{"KanjiDate_Daiji", KanjiDate, Daiji, time.Date(2020, time.October, 1, 0, 0, 0, 0, jst), "弐千弐拾年壱拾月壱日"},
+	{"KanjiDate_DaijiKigenzen", KanjiDate, Daiji, time.Date(-9, time.January, 2, 0, 0, 0, 0, jst), "紀元前壱拾年壱月弐日"},
+	{"KanjiDate_Kyuudaiji", KanjiDate, Kyuudaiji, time.Date(2016, time.January, 2, 0, 0, 0, 0, jst), "弐阡壱拾陸年壱月弐日"},
+	{"KanjiTime_Daiji", KanjiTime, Daiji, time.Date(2016, time.January, 2, 13, 4, 5, 0, jst), "壱拾参時四分五秒"},
+	{"Layout_Daiji", "平成十八年一月二日（月）", Daiji, time.Date(2021, time.March, 31, 0, 0, 0, 0, jst), "令和参年参月参拾壱日（水）"},
+	{"Layout_DaijiYear", "二〇〇六年 一五時", Daiji, time.Date(2020, time.January, 2, 10, 0, 0, 0, jst), "弐千弐拾年 壱拾時"},
+	{"JISX0301_Gannen", JISX0301, Gannen, time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), "R01.05.01"},
+}
+
+func TestJpTime_JpFormatOption(t *testing.T) {
+	for _, test := range jpformatOptionTests {
+		jpt := NewJpTime(test.time)
+		result := jpt.JpFormat(test.format, test.opt)
+		if result != test.result {
+			t.Errorf("%s expected %q got %q", test.name, test.result, result)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package jptime
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A ParseError describes a problem parsing a japanese time string.
+type ParseError struct {
+	Layout  string
+	Value   string
+	Message string
+}
+
+// Error returns the string representation of a ParseError.
+func (e *ParseError) Error() string {
+	if e.Layout == "" {
+		return "jptime: parsing " + strconv.Quote(e.Value) + ": " + e.Message
+	}
+	return "jptime: parsing " + strconv.Quote(e.Value) + " as " + strconv.Quote(e.Layout) + ": " + e.Message
+}
+
+// JpParse parses a japanese formatted string and returns the time value it represents.
+// The layout must be one of the predefined layouts for JpFormat except the weekday layouts.
+// 元年 and full-width digits are accepted.
+// The month and day are those of the gregorian calendar even before 1873, as described for Era.
+func JpParse(layout, value string) (JpTime, error) {
+	switch layout {
+	case ISO8601:
+		t, err := time.Parse(layout, hankaku(value))
+		if err != nil {
+			return JpTime{}, err
+		}
+		return NewJpTime(t), nil
+	case JISX0301, JISX0301JP:
+		return parseJISX0301(layout, value)
+	case KanjiDate, WarekiDate, WarekiKanjiDate:
+		return parseDate(layout, value)
+	case KanjiTime:
+		return parseKanjiTime(value)
+	}
+	return JpTime{}, &ParseError{layout, value, "unsupported layout"}
+}
+
+// H18.01.02, 平18.01.02.
+func parseJISX0301(layout, value string) (JpTime, error) {
+	s := hankaku(value)
+	i := strings.IndexAny(s, "0123456789")
+	if i <= 0 {
+		return JpTime{}, &ParseError{layout, value, "missing era"}
+	}
+	prefix := s[:i]
+	parts := strings.Split(s[i:], ".")
+	if len(parts) != 3 {
+		return JpTime{}, &ParseError{layout, value, "cannot parse date"}
+	}
+	var nums [3]int
+	for j, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return JpTime{}, &ParseError{layout, value, "invalid number " + strconv.Quote(p)}
+		}
+		nums[j] = n
+	}
+
+	name := ""
+	warekiMu.RLock()
+	for _, w := range wareki[Meiji:] {
+		if (layout == JISX0301 && strings.EqualFold(w.initial, prefix)) ||
+			(layout == JISX0301JP && strings.HasPrefix(w.name, prefix)) {
+			name = w.name
+			break
+		}
+	}
+	warekiMu.RUnlock()
+	if name == "" {
+		return JpTime{}, &ParseError{layout, value, "unknown era " + strconv.Quote(prefix)}
+	}
+	return dateInEra(layout, value, name, nums[0], time.Month(nums[1]), nums[2])
+}
+
+// 二〇〇六年一月二日, 平成18年1月2日, 平成十八年一月二日.
+func parseDate(layout, value string) (JpTime, error) {
+	name, rest := splitEraName(hankaku(value))
+	ys, rest, ok1 := cut(rest, "年")
+	ms, rest, ok2 := cut(rest, "月")
+	ds, rest, ok3 := cut(rest, "日")
+	if !ok1 || !ok2 || !ok3 || rest != "" {
+		return JpTime{}, &ParseError{layout, value, "cannot parse date"}
+	}
+
+	switch {
+	case layout == WarekiDate && name == "":
+		if i := strings.IndexAny(ys, "0123456789元"); i > 0 {
+			return JpTime{}, &ParseError{layout, value, "unknown era " + strconv.Quote(ys[:i])}
+		}
+		return JpTime{}, &ParseError{layout, value, "missing era"}
+	case layout == KanjiDate && name != "" && name != "紀元前":
+		return JpTime{}, &ParseError{layout, value, "unexpected era " + strconv.Quote(name)}
+	}
+
+	var nums [3]int
+	for i, s := range [...]string{ys, ms, ds} {
+		var n int
+		var ok bool
+		if layout == WarekiDate {
+			n, ok = parseInt(s)
+		} else {
+			n, ok = parseKanjiInt(s)
+		}
+		if !ok {
+			return JpTime{}, &ParseError{layout, value, "invalid number " + strconv.Quote(s)}
+		}
+		nums[i] = n
+	}
+
+	if name == "" {
+		return date(layout, value, nums[0], time.Month(nums[1]), nums[2])
+	}
+	return dateInEra(layout, value, name, nums[0], time.Month(nums[1]), nums[2])
+}
+
+// 十五時四分五秒.
+func parseKanjiTime(value string) (JpTime, error) {
+	hs, rest, ok1 := cut(value, "時")
+	ms, rest, ok2 := cut(rest, "分")
+	ss, rest, ok3 := cut(rest, "秒")
+	if !ok1 || !ok2 || !ok3 || rest != "" {
+		return JpTime{}, &ParseError{KanjiTime, value, "cannot parse time"}
+	}
+	h, ok1 := parseKanjiInt(hs)
+	m, ok2 := parseKanjiInt(ms)
+	s, ok3 := parseKanjiInt(ss)
+	if !ok1 || !ok2 || !ok3 {
+		return JpTime{}, &ParseError{KanjiTime, value, "invalid number"}
+	}
+	if h > 23 || m > 59 || s > 59 {
+		return JpTime{}, &ParseError{KanjiTime, value, "time out of range"}
+	}
+	return NewJpTime(time.Date(0, time.January, 1, h, m, s, 0, jst)), nil
+}
+
+// ParseAny parses a free-form japanese date string such as
+// "平成18年1月2日（月）", "Ｈ１８．１．２", "令和元年五月一日", "二〇〇六年一月二日" or "2006/01/02".
+// Full-width characters, spaces, 元年, era abbreviations (平, H, h) and a trailing weekday are accepted.
+// The month and day are those of the gregorian calendar even before 1873, as described for Era,
+// so "明治5年12月3日" is 1872-12-03 and not 1873-01-01 on which the gregorian calendar was adopted.
+func ParseAny(s string) (JpTime, error) {
+	value := normalize(s)
+	value, wd, hasWeekday := splitWeekday(value)
+
+	name, rest := splitEraAbbr(value)
+	var fields []string
+	if ys, r, ok := cut(rest, "年"); ok {
+		ms, r, _ := cut(r, "月")
+		ds, r, _ := cut(r, "日")
+		if r != "" {
+			return JpTime{}, &ParseError{"", s, "unexpected " + strconv.Quote(r)}
+		}
+		fields = []string{ys, ms, ds}
+	} else {
+		fields = strings.FieldsFunc(rest, func(r rune) bool { return r == '.' || r == '/' || r == '-' })
+	}
+	if len(fields) != 3 {
+		return JpTime{}, &ParseError{"", s, "cannot parse date"}
+	}
+
+	var nums [3]int
+	for i, f := range fields {
+		n, ok := parseInt(f)
+		if !ok {
+			n, ok = parseKanjiInt(f)
+		}
+		if !ok {
+			return JpTime{}, &ParseError{"", s, "invalid number " + strconv.Quote(f)}
+		}
+		nums[i] = n
+	}
+
+	var t JpTime
+	var err error
+	if name == "" {
+		t, err = date("", s, nums[0], time.Month(nums[1]), nums[2])
+	} else {
+		t, err = dateInEra("", s, name, nums[0], time.Month(nums[1]), nums[2])
+	}
+	if err != nil {
+		return JpTime{}, err
+	}
+	if hasWeekday && t.JpWeekday() != wd {
+		return JpTime{}, &ParseError{"", s, "weekday " + wd.String() + " does not match " + t.JpWeekday().String()}
+	}
+	return t, nil
+}
+
+// normalize converts full-width alphanumerics to half-width and removes spaces.
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == ' ' || r == '\t' || r == '　':
+			return -1
+		case r >= '！' && r <= '～':
+			return r - '！' + '!'
+		}
+		return r
+	}, s)
+}
+
+// splitWeekday removes a trailing weekday such as （月）, (月曜), 月曜日.
+func splitWeekday(s string) (string, JpWeekday, bool) {
+	for w, d := range jpdays {
+		for _, f := range [...]string{"(" + d + ")", "(" + d + "曜)", "(" + d + "曜日)", d + "曜", d + "曜日"} {
+			if strings.HasSuffix(s, f) {
+				return s[:len(s)-len(f)], JpWeekday(w), true
+			}
+		}
+	}
+	return s, 0, false
+}
+
+// splitEraAbbr splits the era name, its first letter (平) or its initial (H, h) from s.
+func splitEraAbbr(s string) (string, string) {
+	if name, rest := splitEraName(s); name != "" {
+		return name, rest
+	}
+	warekiMu.RLock()
+	defer warekiMu.RUnlock()
+	for _, w := range wareki[Meiji:] {
+		for _, abbr := range [...]string{string([]rune(w.name)[0]), w.initial} {
+			if len(s) > len(abbr) && strings.EqualFold(s[:len(abbr)], abbr) {
+				return w.name, s[len(abbr):]
+			}
+		}
+	}
+	return "", s
+}
+
+// dateInEra returns the date of year/month/day in the era named name.
+func dateInEra(layout, value, name string, year int, month time.Month, day int) (JpTime, error) {
+	var firsts []int
+	warekiMu.RLock()
+	for i, w := range wareki {
+		if w.name != name {
+			continue
+		}
+		switch Wareki(i) {
+		case Kigenzen, Seireki:
+			// 西暦・紀元前は元号の期間によらない
+			warekiMu.RUnlock()
+			if year < 1 {
+				return JpTime{}, &ParseError{layout, value, "year out of range"}
+			}
+			if Wareki(i) == Kigenzen {
+				year = 1 - year
+			}
+			return date(layout, value, year, month, day)
+		}
+		firsts = append(firsts, w.start.Year())
+	}
+	warekiMu.RUnlock()
+	for _, g := range gengo {
+		if g.name == name {
+			firsts = append(firsts, g.year)
+			if year == 1 && g.start.Year() > g.year {
+				// 元年の翌年に改元された元号
+				firsts = append(firsts, g.start.Year())
+			}
+		}
+	}
+	if len(firsts) == 0 {
+		return JpTime{}, &ParseError{layout, value, "unknown era " + strconv.Quote(name)}
+	}
+
+	msg := name + FmtInt(year) + "年" + FmtInt(int(month)) + "月" + FmtInt(day) + "日 does not exist in " + name
+	for _, first := range firsts {
+		t := NewJpTime(time.Date(first+year-1, month, day, 0, 0, 0, 0, jst))
+		if t.Month() != month || t.Day() != day {
+			msg = "day out of range"
+			continue
+		}
+		for _, c := range [...]Court{Nanchou, Hokuchou} {
+			if e := t.era(c); e.Name == name && e.Year == year {
+				return t, nil
+			}
+		}
+	}
+	return JpTime{}, &ParseError{layout, value, msg}
+}
+
+// date returns the date of year/month/day in the gregorian calendar.
+func date(layout, value string, year int, month time.Month, day int) (JpTime, error) {
+	t := NewJpTime(time.Date(year, month, day, 0, 0, 0, 0, jst))
+	if t.Month() != month || t.Day() != day {
+		return JpTime{}, &ParseError{layout, value, "day out of range"}
+	}
+	return t, nil
+}
+
+// splitEraName splits the longest era name prefix of s.
+func splitEraName(s string) (string, string) {
+	name := ""
+	warekiMu.RLock()
+	for _, w := range wareki {
+		if len(w.name) > len(name) && strings.HasPrefix(s, w.name) {
+			name = w.name
+		}
+	}
+	warekiMu.RUnlock()
+	for _, g := range gengo {
+		if len(g.name) > len(name) && strings.HasPrefix(s, g.name) {
+			name = g.name
+		}
+	}
+	return name, s[len(name):]
+}
+
+func cut(s, sep string) (string, string, bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+// parseInt parses 記数法 digits or 元.
+func parseInt(s string) (int, bool) {
+	if s == "元" {
+		return 1, true
+	}
+	if s == "" || strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
+
+// parseKanjiInt parses 漢数字 or 元.
+func parseKanjiInt(s string) (int, bool) {
+	if s == "元" {
+		return 1, true
+	}
+	n, err := ParseKanjiInt(s)
+	if err != nil || n < 0 || n > math.MaxInt32 {
+		return 0, false
+	}
+	return int(n), true
+}
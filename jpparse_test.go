@@ -0,0 +1,215 @@
+package jptime
+
+import (
+	"testing"
+	"time"
+)
+
+type JpParseTest struct {
+	name   string
+	layout string
+	value  string
+	time   time.Time
+}
+
+var jpparseTests = []JpParseTest{
+	{"ISO8601", ISO8601, "2006-01-02T15:04:05+09:00", time.Date(2006, time.January, 2, 15, 4, 5, 0, jst)},
+	{"JISX0301", JISX0301, "H18.01.02", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"JISX0301_Reiwa", JISX0301, "R01.05.01", time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	{"JISX0301_Lower", JISX0301, "s64.01.07", time.Date(1989, time.January, 7, 0, 0, 0, 0, jst)},
+	{"JISX0301JP", JISX0301JP, "平18.01.02", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"KanjiDate", KanjiDate, "二〇〇六年一月二日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"KanjiDate_Meisuu", KanjiDate, "二千六年十二月三十一日", time.Date(2006, time.December, 31, 0, 0, 0, 0, jst)},
+	{"KanjiDate_Kigenzen", KanjiDate, "紀元前一年一月二日", time.Date(0, time.January, 2, 0, 0, 0, 0, jst)},
+	{"KanjiTime", KanjiTime, "十五時四分五秒", time.Date(0, time.January, 1, 15, 4, 5, 0, jst)},
+	{"KanjiTime_Zero", KanjiTime, "零時零分零秒", time.Date(0, time.January, 1, 0, 0, 0, 0, jst)},
+	{"WarekiDate", WarekiDate, "平成18年1月2日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"WarekiDate_Gannen", WarekiDate, "令和元年5月1日", time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	{"WarekiDate_Tenpo", WarekiDate, "天保14年3月2日", time.Date(1843, time.March, 2, 0, 0, 0, 0, jst)},
+	{"WarekiDate_TenpoGannen", WarekiDate, "天保元年1月23日", time.Date(1831, time.January, 23, 0, 0, 0, 0, jst)},
+	{"WarekiDate_Seireki", WarekiDate, "西暦600年1月2日", time.Date(600, time.January, 2, 0, 0, 0, 0, jst)},
+	{"WarekiDate_Zenkaku", WarekiDate, "平成１８年１月２日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"JISX0301JP_Zenkaku", JISX0301JP, "平１８.０１.０２", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"ISO8601_Zenkaku", ISO8601, "２００６-０１-０２T１５:０４:０５+０９:００", time.Date(2006, time.January, 2, 15, 4, 5, 0, jst)},
+	{"WarekiKanjiDate", WarekiKanjiDate, "平成十八年一月二日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"WarekiKanjiDate_Gannen", WarekiKanjiDate, "令和元年五月一日", time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	{"WarekiKanjiDate_Manen", WarekiKanjiDate, "万延元年五月一日", time.Date(1860, time.May, 1, 0, 0, 0, 0, jst)},
+	{"WarekiKanjiDate_Kanji", WarekiKanjiDate, "六〇〇年一月二日", time.Date(600, time.January, 2, 0, 0, 0, 0, jst)},
+	{"WarekiKanjiDate_Nanchou", WarekiKanjiDate, "興国元年七月一日", time.Date(1340, time.July, 1, 0, 0, 0, 0, jst)},
+	{"WarekiKanjiDate_Hokuchou", WarekiKanjiDate, "暦応三年七月一日", time.Date(1340, time.July, 1, 0, 0, 0, 0, jst)},
+}
+
+func TestJpParse(t *testing.T) {
+	for _, test := range jpparseTests {
+		jpt, err := JpParse(test.layout, test.value)
+		if err != nil {
+			t.Errorf("%s unexpected error %v", test.name, err)
+			continue
+		}
+		if !jpt.Equal(test.time) {
+			t.Errorf("%s expected %v got %v", test.name, test.time, jpt)
+		}
+	}
+}
+
+var jpparseErrorTests = []JpParseTest{
+	{"ISO8601", ISO8601, "2006-01-02", time.Time{}},
+	{"JISX0301_UnknownEra", JISX0301, "X18.01.02", time.Time{}},
+	{"JISX0301_NotInEra", JISX0301, "H31.05.01", time.Time{}},
+	{"JISX0301_Format", JISX0301, "H18-01-02", time.Time{}},
+	{"JISX0301JP_NotInEra", JISX0301JP, "令01.04.30", time.Time{}},
+	{"KanjiDate_Era", KanjiDate, "平成十八年一月二日", time.Time{}},
+	{"KanjiDate_Day", KanjiDate, "二〇〇六年二月三十日", time.Time{}},
+	{"KanjiTime_Range", KanjiTime, "二十四時零分零秒", time.Time{}},
+	{"WarekiDate_MissingEra", WarekiDate, "18年1月2日", time.Time{}},
+	{"WarekiDate_UnknownEra", WarekiDate, "大和1年1月2日", time.Time{}},
+	{"WarekiDate_NotInEra", WarekiDate, "平成32年1月2日", time.Time{}},
+	{"WarekiDate_BeforeEra", WarekiDate, "令和元年4月30日", time.Time{}},
+	{"WarekiDate_Number", WarekiDate, "平成十八年1月2日", time.Time{}},
+	{"WarekiKanjiDate_Day", WarekiKanjiDate, "平成十八年二月二十九日", time.Time{}},
+	{"WarekiKanjiDate_Format", WarekiKanjiDate, "平成十八年一月", time.Time{}},
+	{"JpWeekdayString", JpWeekdayString, "月曜日", time.Time{}},
+}
+
+func TestJpParse_Error(t *testing.T) {
+	for _, test := range jpparseErrorTests {
+		if jpt, err := JpParse(test.layout, test.value); err == nil {
+			t.Errorf("%s expected error got %v", test.name, jpt)
+		}
+	}
+}
+
+func TestJpParse_RoundTrip(t *testing.T) {
+	tm := time.Date(1860, time.January, 1, 0, 0, 0, 0, jst)
+	for i := 0; i < 365*170; i += 13 {
+		jpt := NewJpTime(tm.AddDate(0, 0, i))
+		for _, layout := range [...]string{KanjiDate, WarekiDate, WarekiKanjiDate} {
+			for _, s := range [...]string{jpt.JpFormat(layout), jpt.JpFormat(layout, Gannen), jpt.JpFormat(layout, Zenkaku)} {
+				newJpt, err := JpParse(layout, s)
+				if err != nil {
+					t.Errorf("JpParse %v %q %v", jpt, s, err)
+				} else if !newJpt.Equal(jpt.Time) {
+					t.Errorf("JpParse %q expected %v got %v", s, jpt, newJpt)
+				}
+			}
+		}
+	}
+	for _, g := range gengo {
+		jpt := NewJpTime(g.start)
+		s := jpt.JpFormat(WarekiDate)
+		if newJpt, err := JpParse(WarekiDate, s); err != nil || !newJpt.Equal(jpt.Time) {
+			t.Errorf("JpParse %q expected %v got %v %v", s, jpt, newJpt, err)
+		}
+	}
+}
+
+type ParseAnyTest struct {
+	value string
+	time  time.Time
+}
+
+var parseanyTests = []ParseAnyTest{
+	{"平成18年1月2日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成１８年１月２日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成 18年 1月 2日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成　１８年　１月　２日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成18年1月2日（月）", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成18年1月2日(月)", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成18年1月2日 (月曜)", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成18年1月2日月曜日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成18年1月2日 月曜", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成十八年一月二日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成一八年一月二日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平18年1月2日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平18.1.2", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平18.01.02", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"平成18.01.02", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"H18.01.02", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"h18.01.02", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"H18/1/2", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"H18-1-2", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"Ｈ１８．１．２", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"ｈ１８／０１／０２", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"H18年1月2日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"H.18.1.2", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"令和元年5月1日", time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	{"令和元年五月一日", time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	{"令和元年５月１日（水）", time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	{"令元.5.1", time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	{"R1.5.1", time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	{"r01.05.01", time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	{"R2/12/31", time.Date(2020, time.December, 31, 0, 0, 0, 0, jst)},
+	{"昭和64年1月7日", time.Date(1989, time.January, 7, 0, 0, 0, 0, jst)},
+	{"昭64.1.7", time.Date(1989, time.January, 7, 0, 0, 0, 0, jst)},
+	{"S64.1.7", time.Date(1989, time.January, 7, 0, 0, 0, 0, jst)},
+	{"昭和元年12月25日", time.Date(1926, time.December, 25, 0, 0, 0, 0, jst)},
+	{"大正元年7月30日", time.Date(1912, time.July, 30, 0, 0, 0, 0, jst)},
+	{"大1.7.30", time.Date(1912, time.July, 30, 0, 0, 0, 0, jst)},
+	{"T15.12.24", time.Date(1926, time.December, 24, 0, 0, 0, 0, jst)},
+	{"明治45年7月29日", time.Date(1912, time.July, 29, 0, 0, 0, 0, jst)},
+	{"M6.1.1", time.Date(1873, time.January, 1, 0, 0, 0, 0, jst)},
+	{"明6.1.1", time.Date(1873, time.January, 1, 0, 0, 0, 0, jst)},
+	{"天保14年3月2日", time.Date(1843, time.March, 2, 0, 0, 0, 0, jst)},
+	{"天保十四年三月二日", time.Date(1843, time.March, 2, 0, 0, 0, 0, jst)},
+	{"明治5年12月3日", time.Date(1872, time.December, 3, 0, 0, 0, 0, jst)},
+	{"万延元年五月一日", time.Date(1860, time.May, 1, 0, 0, 0, 0, jst)},
+	{"慶応四年一月一日", time.Date(1868, time.January, 1, 0, 0, 0, 0, jst)},
+	{"2006年1月2日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"２００６年１月２日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"2006年01月02日(月)", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"二〇〇六年一月二日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"二千六年一月二日", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"二〇〇六年十二月三十一日", time.Date(2006, time.December, 31, 0, 0, 0, 0, jst)},
+	{"2006/01/02", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"2006/1/2", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"2006-01-02", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"2006.01.02", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"２００６／０１／０２", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{" 2006-01-02 ", time.Date(2006, time.January, 2, 0, 0, 0, 0, jst)},
+	{"2016/12/25（日）", time.Date(2016, time.December, 25, 0, 0, 0, 0, jst)},
+	{"2016年12月25日日曜日", time.Date(2016, time.December, 25, 0, 0, 0, 0, jst)},
+	{"西暦2016年12月25日", time.Date(2016, time.December, 25, 0, 0, 0, 0, jst)},
+	{"紀元前一年一月二日", time.Date(0, time.January, 2, 0, 0, 0, 0, jst)},
+}
+
+func TestParseAny(t *testing.T) {
+	for _, test := range parseanyTests {
+		jpt, err := ParseAny(test.value)
+		if err != nil {
+			t.Errorf("ParseAny %q unexpected error %v", test.value, err)
+			continue
+		}
+		if !jpt.Equal(test.time) {
+			t.Errorf("ParseAny %q expected %v got %v", test.value, test.time, jpt)
+		}
+	}
+}
+
+var parseanyErrorTests = []string{
+	"",
+	"平成",
+	"平成18年",
+	"平成18年1月",
+	"平成18年1月2日3時",
+	"平成32年1月2日",
+	"令和元年4月30日",
+	"H31.5.1",
+	"X18.01.02",
+	"大和1年1月1日",
+	"2006/01",
+	"2006/01/02/03",
+	"2006/02/30",
+	"2006/13/01",
+	"平成18年1月2日（火）",
+	"2006年一月二日曜日",
+	"平成十八年一月二十日日",
+	"abc",
+}
+
+func TestParseAny_Error(t *testing.T) {
+	for _, value := range parseanyErrorTests {
+		if jpt, err := ParseAny(value); err == nil {
+			t.Errorf("ParseAny %q expected error got %v", value, jpt)
+		}
+	}
+}
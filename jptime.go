@@ -2,7 +2,15 @@
 // 日本の祝日・暦・季節・時刻表示のためのパッケージ
 package jptime
 
-import "time"
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
 
 // A JpTime represents an instant in japanese time.
 type JpTime struct {
@@ -11,9 +19,12 @@ type JpTime struct {
 
 // NewJpTime returns JpTime.
 func NewJpTime(t time.Time) JpTime {
-	return JpTime{t.In(time.FixedZone("Asia/Tokyo", 9*60*60))}
+	return JpTime{t.In(jst)}
 }
 
+// 日本標準時. 日付は time.Local によらず jst で求める.
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
 var chineseNumerals = [...]rune{
 	'〇',
 	'一',
@@ -32,56 +43,303 @@ var digitChineseNumerals = [...]rune{
 	'十',
 	'百',
 	'千',
-	'万',
 }
 
-// FmtInt returns 数字（記数法）[0<].
+var zenkakuNumerals = [...]rune{
+	'０',
+	'１',
+	'２',
+	'３',
+	'４',
+	'５',
+	'６',
+	'７',
+	'８',
+	'９',
+}
+
+// FmtInt returns 数字（記数法）.
 func FmtInt(i int) string {
-	buf := make([]rune, 0, 10)
-	for i > 0 {
-		buf = append(buf, rune(i%10)+'0')
-		i /= 10
+	return FmtInt64(int64(i))
+}
+
+// FmtInt64 returns 数字（記数法）.
+func FmtInt64(i int64) string {
+	return string(AppendFmtInt(make([]byte, 0, 20), i))
+}
+
+// AppendFmtInt appends 数字（記数法） to dst and returns the extended buffer.
+func AppendFmtInt(dst []byte, i int64) []byte {
+	return strconv.AppendInt(dst, i, 10)
+}
+
+// FmtIntZenkaku returns 全角数字（記数法）.
+// Negative numbers are prefixed with －.
+func FmtIntZenkaku(i int) string {
+	return FmtInt64Zenkaku(int64(i))
+}
+
+// FmtInt64Zenkaku returns 全角数字（記数法）.
+func FmtInt64Zenkaku(i int64) string {
+	return string(AppendFmtIntZenkaku(make([]byte, 0, 60), i))
+}
+
+// AppendFmtIntZenkaku appends 全角数字（記数法） to dst and returns the extended buffer.
+func AppendFmtIntZenkaku(dst []byte, i int64) []byte {
+	return appendDigits(dst, i, &zenkakuNumerals, "－")
+}
+
+// FmtIntKanji returns 漢数字（記数法）. 0 is 〇.
+// Negative numbers are prefixed with マイナス, or 負 with the Fu option.
+func FmtIntKanji(i int, opts ...FormatOption) string {
+	return FmtInt64Kanji(int64(i), opts...)
+}
+
+// FmtInt64Kanji returns 漢数字（記数法）.
+func FmtInt64Kanji(i int64, opts ...FormatOption) string {
+	return string(AppendFmtIntKanji(make([]byte, 0, 60), i, opts...))
+}
+
+// AppendFmtIntKanji appends 漢数字（記数法） to dst and returns the extended buffer.
+func AppendFmtIntKanji(dst []byte, i int64, opts ...FormatOption) []byte {
+	var o FormatOption
+	for _, opt := range opts {
+		o |= opt
 	}
-	return string(reverseRune(buf))
+	return appendDigits(dst, i, &chineseNumerals, o.minus())
 }
 
-// FmtIntKanji returns 漢数字（記数法）[0<]
-func FmtIntKanji(i int) string {
-	buf := make([]rune, 0, 10)
-	for i > 0 {
-		buf = append(buf, chineseNumerals[i%10])
-		i /= 10
+// appendDigits appends i in 記数法 using numerals.
+func appendDigits(dst []byte, i int64, numerals *[10]rune, minus string) []byte {
+	u := uint64(i)
+	if i < 0 {
+		dst = append(dst, minus...)
+		u = uint64(-i)
 	}
-	return string(reverseRune(buf))
+	var buf [20]rune
+	n := len(buf)
+	for {
+		n--
+		buf[n] = numerals[u%10]
+		u /= 10
+		if u == 0 {
+			break
+		}
+	}
+	for _, r := range buf[n:] {
+		dst = utf8.AppendRune(dst, r)
+	}
+	return dst
+}
+
+// FmtIntKanjiMeisuu returns 漢数字（命数法）. 0 is 零.
+// Negative numbers are prefixed with マイナス, or 負 with the Fu option.
+func FmtIntKanjiMeisuu(i int, opts ...FormatOption) string {
+	return FmtInt64KanjiMeisuu(int64(i), opts...)
+}
+
+var myriadChineseNumerals = [...]rune{
+	'一',
+	'万',
+	'億',
+	'兆',
+	'京',
 }
 
-// FmtIntKanjiMeisuu returns 漢数字（命数法）[0, 99999]
-func FmtIntKanjiMeisuu(i int) string {
-	if i < 0 || i >= 100000 {
-		return ""
+// FmtInt64KanjiMeisuu returns 漢数字（命数法）, grouped by 万・億・兆・京.
+// With the Issen option 千 is written as 一千, with the Daiji option in 大字 as 壱拾・壱百・壱千.
+func FmtInt64KanjiMeisuu(i int64, opts ...FormatOption) string {
+	return string(AppendFmtIntKanjiMeisuu(make([]byte, 0, 60), i, opts...))
+}
+
+// AppendFmtIntKanjiMeisuu appends 漢数字（命数法） to dst and returns the extended buffer.
+func AppendFmtIntKanjiMeisuu(dst []byte, i int64, opts ...FormatOption) []byte {
+	var o FormatOption
+	for _, opt := range opts {
+		o |= opt
 	}
 	if i == 0 {
-		return "零"
+		return append(dst, "零"...)
+	}
+	u := uint64(i)
+	if i < 0 {
+		dst = append(dst, o.minus()...)
+		u = uint64(-i)
 	}
 
-	buf := make([]rune, 0, 10)
-	for digit := 0; i > 0; digit++ {
-		if digit > 0 && i%10 > 0 {
-			buf = append(buf, digitChineseNumerals[digit])
+	// 下の位から逆順に詰める
+	var buf [64]rune
+	n := len(buf)
+	for myriad := 0; u > 0; myriad++ {
+		if m := int(u % 10000); m > 0 {
+			if myriad > 0 {
+				n--
+				buf[n] = myriadChineseNumerals[myriad]
+			}
+			for digit := 0; m > 0; digit++ {
+				if digit > 0 && m%10 > 0 {
+					n--
+					buf[n] = digitChineseNumerals[digit]
+				}
+				if (digit == 0 && m%10 > 0) || m%10 > 1 || (m%10 == 1 && (o&(Daiji|Kyuudaiji) != 0 || digit == 3 && o&Issen != 0)) {
+					n--
+					buf[n] = chineseNumerals[m%10]
+				}
+				m /= 10
+			}
 		}
-		if (digit == 0 && i%10 > 0) || i%10 > 1 {
-			buf = append(buf, chineseNumerals[i%10])
+		u /= 10000
+	}
+	for _, r := range buf[n:] {
+		dst = utf8.AppendRune(dst, o.daijiRune(r))
+	}
+	return dst
+}
+
+// FmtIntDaiji returns 大字（命数法）, such as 壱万弐千参百四拾五.
+// With the Kyuudaiji option 四〜九・百・千・万 are also written in 大字.
+func FmtIntDaiji(i int, opts ...FormatOption) string {
+	return FmtInt64KanjiMeisuu(int64(i), append(opts, Daiji)...)
+}
+
+// ParseKanjiInt parses 漢数字 in 記数法 (二〇一六), 命数法 (二千十六, 一億二千万) or 大字 (壱萬),
+// optionally mixed with arabic digits (1万2千) and prefixed with マイナス or 負.
+func ParseKanjiInt(s string) (int64, error) {
+	const fn = "ParseKanjiInt"
+	str := s
+	neg := false
+	for _, prefix := range [...]string{"マイナス", "負", "-", "−", "－"} {
+		if strings.HasPrefix(str, prefix) {
+			neg = true
+			str = str[len(prefix):]
+			break
+		}
+	}
+	if str == "" {
+		return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+	}
+
+	// total: 万以上の位の合計, section: 万未満の位の合計, cur: 位の付かない数字
+	var total, section, cur int64
+	digits, lastUnit, lastMyriad := 0, 4, 20
+	for _, r := range str {
+		if d, ok := kanjiDigit(r); ok {
+			if cur > (math.MaxInt64-d)/10 {
+				return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrRange}
+			}
+			cur = cur*10 + d
+			digits++
+			continue
+		}
+
+		exp, ok := kanjiUnit(r)
+		switch {
+		case !ok:
+			return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+		case exp < 4:
+			// 十・百・千
+			if exp >= lastUnit || digits > 1 {
+				return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+			}
+			if digits == 0 {
+				cur = 1
+			}
+			section += cur * pow10(exp)
+			lastUnit = exp
+		default:
+			// 万・億・兆・京
+			if exp >= lastMyriad || (digits > 1 && section > 0) {
+				return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+			}
+			section += cur
+			if section == 0 && digits == 0 {
+				if total > 0 {
+					return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+				}
+				section = 1
+			}
+			if section > (math.MaxInt64-total)/pow10(exp) {
+				return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrRange}
+			}
+			total += section * pow10(exp)
+			section = 0
+			lastUnit, lastMyriad = 4, exp
 		}
-		i /= 10
+		cur, digits = 0, 0
+	}
+	if digits > 1 && section > 0 {
+		return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+	}
+	if section+cur > math.MaxInt64-total {
+		return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrRange}
+	}
+	total += section + cur
+	if neg {
+		total = -total
+	}
+	return total, nil
+}
+
+// 漢数字・大字・算用数字の値.
+func kanjiDigit(r rune) (int64, bool) {
+	switch r {
+	case '〇', '零':
+		return 0, true
+	case '一', '壱', '壹', '弌':
+		return 1, true
+	case '二', '弐', '貳', '弍':
+		return 2, true
+	case '三', '参', '參', '弎':
+		return 3, true
+	case '四', '肆':
+		return 4, true
+	case '五', '伍':
+		return 5, true
+	case '六', '陸':
+		return 6, true
+	case '七', '漆', '柒':
+		return 7, true
+	case '八', '捌':
+		return 8, true
+	case '九', '玖':
+		return 9, true
 	}
-	return string(reverseRune(buf))
+	switch {
+	case r >= '0' && r <= '9':
+		return int64(r - '0'), true
+	case r >= '０' && r <= '９':
+		return int64(r - '０'), true
+	}
+	return 0, false
 }
 
-func reverseRune(runes []rune) []rune {
-	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
-		runes[i], runes[j] = runes[j], runes[i]
+// 位の指数.
+func kanjiUnit(r rune) (int, bool) {
+	switch r {
+	case '十', '拾':
+		return 1, true
+	case '百', '佰', '陌':
+		return 2, true
+	case '千', '阡', '仟':
+		return 3, true
+	case '万', '萬':
+		return 4, true
+	case '億':
+		return 8, true
+	case '兆':
+		return 12, true
+	case '京':
+		return 16, true
 	}
-	return runes
+	return 0, false
+}
+
+func pow10(n int) int64 {
+	p := int64(1)
+	for ; n > 0; n-- {
+		p *= 10
+	}
+	return p
 }
 
 // A JpYear specifies a year.
@@ -178,45 +436,184 @@ const (
 	Taisho
 	Showa
 	Heisei
+	Reiwa
 )
 
+// PreMeiji is the Wareki of the eras before 明治.
+const PreMeiji Wareki = -1
+
 type jpTimeWareki struct {
 	name    string
 	initial string
+	reading string
+	romaji  string
 	start   time.Time
 }
 
-var wareki = [...]jpTimeWareki{
-	{name: "紀元前", initial: "B.C."},
-	{name: "西暦", initial: "A.D.", start: time.Date(1, time.January, 1, 0, 0, 0, 0, time.Local)},
-	{name: "明治", initial: "M", start: time.Date(1873, time.January, 1, 0, 0, 0, 0, time.Local)},
-	{name: "大正", initial: "T", start: time.Date(1912, time.July, 30, 0, 0, 0, 0, time.Local)},
-	{name: "昭和", initial: "S", start: time.Date(1926, time.December, 25, 0, 0, 0, 0, time.Local)},
-	{name: "平成", initial: "H", start: time.Date(1989, time.January, 8, 0, 0, 0, 0, time.Local)},
+var (
+	warekiMu sync.RWMutex
+	wareki   = []jpTimeWareki{
+		{name: "紀元前", initial: "B.C.", reading: "きげんぜん", romaji: "Kigenzen"},
+		{name: "西暦", initial: "A.D.", reading: "せいれき", romaji: "Seireki", start: time.Date(1, time.January, 1, 0, 0, 0, 0, jst)},
+		{name: "明治", initial: "M", reading: "めいじ", romaji: "Meiji", start: time.Date(1868, time.October, 23, 0, 0, 0, 0, jst)},
+		{name: "大正", initial: "T", reading: "たいしょう", romaji: "Taishō", start: time.Date(1912, time.July, 30, 0, 0, 0, 0, jst)},
+		{name: "昭和", initial: "S", reading: "しょうわ", romaji: "Shōwa", start: time.Date(1926, time.December, 25, 0, 0, 0, 0, jst)},
+		{name: "平成", initial: "H", reading: "へいせい", romaji: "Heisei", start: time.Date(1989, time.January, 8, 0, 0, 0, 0, jst)},
+		{name: "令和", initial: "R", reading: "れいわ", romaji: "Reiwa", start: time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	}
+)
+
+// RegisterEra appends a new era (新元号) to the era table used by Wareki and JpFormat.
+// initial must be a single ASCII letter not used by the other eras, as it is in JIS X 0301.
+// start must be after the start of the current last era.
+func RegisterEra(name, initial string, start time.Time) error {
+	if name == "" {
+		return errors.New("jptime: era name must not be empty")
+	}
+	if len(initial) != 1 || !('A' <= initial[0] && initial[0] <= 'Z' || 'a' <= initial[0] && initial[0] <= 'z') {
+		return errors.New("jptime: era initial " + strconv.Quote(initial) + " must be an ASCII letter")
+	}
+
+	warekiMu.Lock()
+	defer warekiMu.Unlock()
+	for _, w := range wareki {
+		if w.name == name {
+			return errors.New("jptime: era " + name + " is already registered")
+		}
+		if strings.EqualFold(w.initial, initial) {
+			return errors.New("jptime: era initial " + initial + " is already used by " + w.name)
+		}
+	}
+	if last := wareki[len(wareki)-1]; !start.After(last.start) {
+		return errors.New("jptime: era " + name + " must start after " + last.name)
+	}
+	wareki = append(wareki, jpTimeWareki{name: name, initial: initial, start: start})
+	return nil
+}
+
+// An Era represents 元号 and the year in it.
+// 旧暦 is not used: the year changes on January 1 and the month and day of a date before 1873
+// are those of the proleptic gregorian calendar. An era proclaimed in the gregorian year after 元年
+// is 元年 until the 旧暦 new year, which is approximated from the new moon.
+type Era struct {
+	Wareki  Wareki    // 明治以前の元号は PreMeiji
+	Name    string    // 漢字表記
+	Romaji  string    // ローマ字表記
+	Initial string    // 略号（明治以前は空）
+	Reading string    // 読み
+	Start   time.Time // 改元日
+	End     time.Time // 次の元号の改元日（現元号はゼロ値）
+	Year    int       // 元号での年
+	first   int       // 元年の西暦年
 }
 
-// Wareki returns 和暦.
-func (t JpTime) Wareki() (string, string, int) {
-	var name, initial string
-	var year int
+// Era returns the era of t.
+func (t JpTime) Era() Era {
+	warekiMu.RLock()
+	c := court
+	warekiMu.RUnlock()
+	return t.era(c)
+}
+
+// 最後の元号は終了日を持たないため、新元号はテーブルへの追加のみで対応できる.
+func (t JpTime) era(c Court) Era {
+	warekiMu.RLock()
+	defer warekiMu.RUnlock()
 	for i, w := range wareki {
-		if (Wareki(i) == Kigenzen || t.After(w.start) || t.Equal(w.start)) &&
-			(Wareki(i) == Heisei || t.Before(wareki[i+1].start)) {
-			name = w.name
-			initial = w.initial
+		if (Wareki(i) == Kigenzen || !t.Before(w.start)) &&
+			(i == len(wareki)-1 || t.Before(wareki[i+1].start)) {
+			e := Era{
+				Wareki:  Wareki(i),
+				Name:    w.name,
+				Romaji:  w.romaji,
+				Initial: w.initial,
+				Reading: w.reading,
+				Start:   w.start,
+				first:   w.start.Year(),
+			}
+			if i < len(wareki)-1 {
+				e.End = wareki[i+1].start
+			}
 			switch Wareki(i) {
 			case Kigenzen:
-				year = 1 - t.Year()
-			case Meiji:
-				// 明治6年からグレゴリオ暦採用のため
-				year = t.Year() - w.start.Year() + 5 + 1
-			default:
-				year = t.Year() - w.start.Year() + 1
+				e.first = 0
+				e.Year = 1 - t.Year()
+				return e
+			case Seireki:
+				if g, end, ok := t.gengo(c); ok {
+					e = Era{
+						Wareki:  PreMeiji,
+						Name:    g.name,
+						Romaji:  g.romaji,
+						Reading: g.reading,
+						Start:   g.start,
+						End:     end,
+						first:   g.year,
+					}
+				}
 			}
-			break
+			e.Year = t.Year() - e.first + 1
+			if e.Wareki == PreMeiji && e.Year == 2 && e.Start.Year() == t.Year() && t.Before(kyuurekiNewYear(t.Year())) {
+				// 元年の翌年の旧暦の元日より前に改元された
+				e.Year = 1
+			}
+			return e
 		}
 	}
-	return name, initial, year
+	return Era{}
+}
+
+// Wareki returns 和暦.
+func (t JpTime) Wareki() (string, string, int) {
+	e := t.Era()
+	return e.Name, e.Initial, e.Year
+}
+
+// WarekiCourt returns 和暦, using the eras of court c for the 南北朝 period.
+func (t JpTime) WarekiCourt(c Court) (string, string, int) {
+	e := t.era(c)
+	return e.Name, e.Initial, e.Year
+}
+
+func (e Era) String() string { return e.Name + FmtInt(e.Year) + "年" }
+
+// Before reports whether the year of e is before that of u.
+func (e Era) Before(u Era) bool {
+	return e.westernYear() < u.westernYear() ||
+		(e.westernYear() == u.westernYear() && e.Start.Before(u.Start))
+}
+
+// After reports whether the year of e is after that of u.
+func (e Era) After(u Era) bool { return u.Before(e) }
+
+// Equal reports whether e and u represent the same year of the same era.
+func (e Era) Equal(u Era) bool {
+	return e.Name == u.Name && e.Start.Equal(u.Start) && e.Year == u.Year
+}
+
+// Contains reports whether t is within the era e.
+func (e Era) Contains(t JpTime) bool {
+	return (e.Wareki == Kigenzen || !t.Before(e.Start)) && (e.End.IsZero() || t.Before(e.End))
+}
+
+// Date returns the JpTime of year/month/day in the era e at 0:00 JST.
+// It does not check that the date is within the era; use Contains for that.
+func (e Era) Date(year int, month time.Month, day int) JpTime {
+	e.Year = year
+	y := e.westernYear()
+	if e.Wareki == PreMeiji && year == 1 && e.Start.Year() > y {
+		// 元年の翌年に改元された元号
+		y = e.Start.Year()
+	}
+	return NewJpTime(time.Date(y, month, day, 0, 0, 0, 0, jst))
+}
+
+// 西暦年.
+func (e Era) westernYear() int {
+	if e.Wareki == Kigenzen {
+		return 1 - e.Year
+	}
+	return e.first + e.Year - 1
 }
 
 var eto = [...]string{
@@ -306,147 +703,18 @@ func (t JpTime) Sekki24() (bool, string) {
 		return false, ""
 	}
 
-	if t.Month() == time.January || t.Month() == time.February {
-		year--
-	}
-
 	for _, s := range sekki24 {
-		if t.Month() == s.month && t.Day() == int(s.d+s.a*float64(year-1900)-float64(int((year-1900)/4))) {
+		if t.Month() == s.month && t.Day() == s.day(year) {
 			return true, s.name
 		}
 	}
 	return false, ""
 }
 
-// Holiday returns 祝日名
-func (t JpTime) Holiday() (bool, string) {
-	isHoliday, holidayName := t.holiday()
-	if !isHoliday {
-		isHoliday, holidayName = t.furikaeHoliday()
-		if !isHoliday {
-			isHoliday, holidayName = t.kokuminHoliday()
-		}
-	}
-	return isHoliday, holidayName
-}
-
-// 祝日名.
-func (t JpTime) holiday() (bool, string) {
-	if t.Year() < 1948 {
-		return false, ""
-	}
-
-	switch t.Month() {
-	case time.January:
-		if t.Day() == 1 {
-			return true, "元日"
-		} else if (t.Year() >= 2000 && t.happyMonday(2)) || (t.Year() < 2000 && t.Day() == 15) {
-			return true, "成人の日"
-		}
-	case time.February:
-		if t.Day() == 11 {
-			return true, "建国記念の日"
-		}
-	case time.March:
-		if _, sekki := t.Sekki24(); sekki == "春分" {
-			return true, "春分の日"
-		}
-	case time.April:
-		if t.Day() == 29 {
-			if t.Year() >= 2007 {
-				return true, "昭和の日"
-			} else if t.Year() >= 1989 {
-				return true, "みどりの日"
-			} else {
-				return true, "天皇誕生日"
-			}
-		}
-	case time.May:
-		if t.Day() == 3 {
-			return true, "憲法記念日"
-		} else if t.Year() >= 2007 && t.Day() == 4 {
-			return true, "みどりの日"
-		} else if t.Day() == 5 {
-			return true, "こどもの日"
-		}
-	case time.June:
-	case time.July:
-		if (t.Year() >= 2003 && t.happyMonday(3)) || (t.Year() < 2003 && t.Year() >= 1995 && t.Day() == 20) {
-			return true, "海の日"
-		}
-	case time.August:
-		if t.Year() >= 2016 && t.Day() == 11 {
-			return true, "山の日"
-		}
-	case time.September:
-		if (t.Year() >= 2003 && t.happyMonday(3)) || (t.Year() < 2003 && t.Year() >= 1966 && t.Day() == 15) {
-			return true, "敬老の日"
-		} else if _, sekki := t.Sekki24(); sekki == "秋分" {
-			return true, "秋分の日"
-		}
-	case time.October:
-		if (t.Year() >= 2003 && t.happyMonday(2)) || (t.Year() < 2003 && t.Year() >= 1966 && t.Day() == 10) {
-			return true, "体育の日"
-		}
-	case time.November:
-		if t.Day() == 3 {
-			return true, "文化の日"
-		} else if t.Day() == 23 {
-			return true, "勤労感謝の日"
-		}
-	case time.December:
-		if t.Year() >= 1989 && t.Day() == 23 {
-			return true, "天皇誕生日"
-		}
-	}
-	return false, ""
-}
-
-// ハッピーマンデー.
-func (t JpTime) happyMonday(wnum int) bool {
-	if t.Weekday() == time.Monday && t.weekNumber() == wnum {
-		return true
-	}
-	return false
-}
-
-// 何週目.
-func (t JpTime) weekNumber() int {
-	return ((t.Day() - 1) / 7) + 1
-}
-
-// 振替休日.
-func (t JpTime) furikaeHoliday() (bool, string) {
-	if t.Year() < 1973 || t.Weekday() == time.Sunday {
-		return false, ""
-	}
-
-	for i := 1; i <= 5; i++ {
-		prevday := NewJpTime(t.AddDate(0, 0, -i))
-		if isHoliday, _ := prevday.holiday(); isHoliday {
-			if prevday.Weekday() == time.Sunday {
-				return true, "振替休日"
-			} else if t.Year() < 2007 {
-				// 2007年までは前日のみチェック
-				break
-			}
-		} else {
-			break
-		}
-	}
-	return false, ""
-}
-
-// 国民の休日.
-func (t JpTime) kokuminHoliday() (bool, string) {
-	if t.Year() < 1988 || t.Weekday() == time.Sunday {
-		return false, ""
-	}
-
-	if yIsHoliday, _ := NewJpTime(t.AddDate(0, 0, -1)).holiday(); yIsHoliday {
-		if tIsholiday, _ := NewJpTime(t.AddDate(0, 0, 1)).holiday(); tIsholiday {
-			return true, "国民の休日"
-		}
+// 二十四節気の日.
+func (s jpTimeSekki24) day(year int) int {
+	if s.month == time.January || s.month == time.February {
+		year--
 	}
-	return false, ""
+	return int(s.d + s.a*float64(year-1900) - float64(int((year-1900)/4)))
 }
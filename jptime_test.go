@@ -2,6 +2,8 @@ package jptime
 
 import (
 	"fmt"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 )
@@ -12,6 +14,8 @@ type JpTimeFmtTest struct {
 }
 
 var fmtinttests = []JpTimeFmtTest{
+	{0, "0"},
+	{-5, "-5"},
 	{1, "1"},
 	{10, "10"},
 	{2000, "2000"},
@@ -27,7 +31,27 @@ func TestFmtInt(t *testing.T) {
 	}
 }
 
+var fmtintzenkakutests = []JpTimeFmtTest{
+	{0, "０"},
+	{-12, "－１２"},
+	{1, "１"},
+	{10, "１０"},
+	{2000, "２０００"},
+	{2016, "２０１６"},
+}
+
+func TestFmtIntZenkaku(t *testing.T) {
+	for _, test := range fmtintzenkakutests {
+		newZ := FmtIntZenkaku(test.num)
+		if newZ != test.str {
+			t.Errorf("FmtIntZenkaku %v = %v", test.str, newZ)
+		}
+	}
+}
+
 var fmtintkanjitests = []JpTimeFmtTest{
+	{0, "〇"},
+	{-12, "マイナス一二"},
 	{1, "一"},
 	{10, "一〇"},
 	{2000, "二〇〇〇"},
@@ -43,13 +67,77 @@ func TestFmtIntKanji(t *testing.T) {
 	}
 }
 
+var fmtint64tests = []struct {
+	num     int64
+	str     string
+	zenkaku string
+	kanji   string
+}{
+	{0, "0", "０", "〇"},
+	{-1, "-1", "－１", "マイナス一"},
+	{9223372036854775807, "9223372036854775807", "９２２３３７２０３６８５４７７５８０７", "九二二三三七二〇三六八五四七七五八〇七"},
+	{-9223372036854775808, "-9223372036854775808", "－９２２３３７２０３６８５４７７５８０８", "マイナス九二二三三七二〇三六八五四七七五八〇八"},
+}
+
+func TestFmtInt64(t *testing.T) {
+	for _, test := range fmtint64tests {
+		if s := FmtInt64(test.num); s != test.str {
+			t.Errorf("FmtInt64 %v = %v", test.str, s)
+		}
+		if s := FmtInt64Zenkaku(test.num); s != test.zenkaku {
+			t.Errorf("FmtInt64Zenkaku %v = %v", test.zenkaku, s)
+		}
+		if s := FmtInt64Kanji(test.num); s != test.kanji {
+			t.Errorf("FmtInt64Kanji %v = %v", test.kanji, s)
+		}
+	}
+}
+
+func TestFmtIntKanji_Fu(t *testing.T) {
+	if s := FmtIntKanji(-12, Fu); s != "負一二" {
+		t.Errorf("FmtIntKanji 負一二 = %v", s)
+	}
+	if s := FmtIntKanjiMeisuu(-12, Fu); s != "負十二" {
+		t.Errorf("FmtIntKanjiMeisuu 負十二 = %v", s)
+	}
+}
+
+func TestAppendFmtInt(t *testing.T) {
+	dst := []byte("令和")
+	dst = AppendFmtInt(dst, 2)
+	dst = AppendFmtIntZenkaku(dst, 2)
+	dst = AppendFmtIntKanji(dst, 2)
+	dst = AppendFmtIntKanjiMeisuu(dst, 12, Daiji)
+	if s := string(dst); s != "令和2２二壱拾弐" {
+		t.Errorf("AppendFmtInt 令和2２二壱拾弐 = %v", s)
+	}
+}
+
+func TestAppendFmtInt_Allocs(t *testing.T) {
+	buf := make([]byte, 0, 512)
+	allocs := testing.AllocsPerRun(100, func() {
+		b := AppendFmtInt(buf[:0], -9223372036854775808)
+		b = AppendFmtIntZenkaku(b, -9223372036854775808)
+		b = AppendFmtIntKanji(b, -9223372036854775808)
+		AppendFmtIntKanjiMeisuu(b, -9223372036854775808)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendFmtInt allocs = %v, want 0", allocs)
+	}
+}
+
 var fmtintkanjimeisuutests = []JpTimeFmtTest{
 	{0, "零"},
 	{1, "一"},
 	{10, "十"},
 	{99, "九十九"},
 	{99999, "九万九千九百九十九"},
-	{100000, ""},
+	{100000, "十万"},
+	{10000000, "千万"},
+	{100010001, "一億一万一"},
+	{123456789, "一億二千三百四十五万六千七百八十九"},
+	{1000000000000, "一兆"},
+	{-1, "マイナス一"},
 }
 
 func TestFmtIntKanjiMeisuu(t *testing.T) {
@@ -61,6 +149,134 @@ func TestFmtIntKanjiMeisuu(t *testing.T) {
 	}
 }
 
+type JpTimeFmtInt64Test struct {
+	num int64
+	opt FormatOption
+	str string
+}
+
+var fmtint64kanjimeisuutests = []JpTimeFmtInt64Test{
+	{0, 0, "零"},
+	{1000, 0, "千"},
+	{1000, Issen, "一千"},
+	{1100, Issen, "一千百"},
+	{11111111, 0, "千百十一万千百十一"},
+	{11111111, Issen, "一千百十一万一千百十一"},
+	{10000000000000000, 0, "一京"},
+	{9223372036854775807, 0, "九百二十二京三千三百七十二兆三百六十八億五千四百七十七万五千八百七"},
+	{-9223372036854775808, 0, "マイナス九百二十二京三千三百七十二兆三百六十八億五千四百七十七万五千八百八"},
+	{-10000, Fu, "負一万"},
+}
+
+func TestFmtInt64KanjiMeisuu(t *testing.T) {
+	for _, test := range fmtint64kanjimeisuutests {
+		newK := FmtInt64KanjiMeisuu(test.num, test.opt)
+		if newK != test.str {
+			t.Errorf("FmtInt64KanjiMeisuu %v = %v", test.str, newK)
+		}
+	}
+}
+
+var fmtintdaijitests = []JpTimeFmtInt64Test{
+	{0, 0, "零"},
+	{1, 0, "壱"},
+	{10, 0, "壱拾"},
+	{23, 0, "弐拾参"},
+	{110, 0, "壱百壱拾"},
+	{1000, 0, "壱千"},
+	{1000, Issen, "壱千"},
+	{10000, 0, "壱万"},
+	{1111, Kyuudaiji, "壱阡壱佰壱拾壱"},
+	{12345, 0, "壱万弐千参百四拾五"},
+	{12345, Kyuudaiji, "壱萬弐阡参佰肆拾伍"},
+	{98760000, Kyuudaiji, "玖阡捌佰漆拾陸萬"},
+}
+
+func TestFmtIntDaiji(t *testing.T) {
+	for _, test := range fmtintdaijitests {
+		newD := FmtIntDaiji(int(test.num), test.opt)
+		if newD != test.str {
+			t.Errorf("FmtIntDaiji %v = %v", test.str, newD)
+		}
+	}
+}
+
+type JpTimeParseKanjiIntTest struct {
+	str string
+	num int64
+	err error
+}
+
+var parsekanjiinttests = []JpTimeParseKanjiIntTest{
+	{"〇", 0, nil},
+	{"零", 0, nil},
+	{"一", 1, nil},
+	{"十", 10, nil},
+	{"十八", 18, nil},
+	{"一八", 18, nil},
+	{"二〇一六", 2016, nil},
+	{"二千十六", 2016, nil},
+	{"一千二百", 1200, nil},
+	{"九万九千九百九十九", 99999, nil},
+	{"万", 10000, nil},
+	{"一億二千三百四十五万六千七百八十九", 123456789, nil},
+	{"一千二百三十四万", 12340000, nil},
+	{"十二万", 120000, nil},
+	{"百万", 1000000, nil},
+	{"一兆一", 1000000000001, nil},
+	{"九百二十二京三千三百七十二兆三百六十八億五千四百七十七万五千八百七", 9223372036854775807, nil},
+	{"壱萬", 10000, nil},
+	{"壱萬弐阡参佰拾", 12310, nil},
+	{"壹拾", 10, nil},
+	{"伍陸漆捌玖", 56789, nil},
+	{"1万2千", 12000, nil},
+	{"1万2000", 12000, nil},
+	{"３億", 300000000, nil},
+	{"マイナス五", -5, nil},
+	{"負十", -10, nil},
+	{"-十", -10, nil},
+	{"", 0, strconv.ErrSyntax},
+	{"マイナス", 0, strconv.ErrSyntax},
+	{"十百", 0, strconv.ErrSyntax},
+	{"二十三四", 0, strconv.ErrSyntax},
+	{"十二千", 0, strconv.ErrSyntax},
+	{"万億", 0, strconv.ErrSyntax},
+	{"一億万", 0, strconv.ErrSyntax},
+	{"二十日", 0, strconv.ErrSyntax},
+	{"一万京", 0, strconv.ErrSyntax},
+	{"千京", 0, strconv.ErrRange},
+	{"九百二十二京三千三百七十二兆三百六十八億五千四百七十七万五千八百八", 0, strconv.ErrRange},
+	{"九九九九九九九九九九九九九九九九九九九九", 0, strconv.ErrRange},
+}
+
+func TestParseKanjiInt(t *testing.T) {
+	for _, test := range parsekanjiinttests {
+		newN, err := ParseKanjiInt(test.str)
+		if test.err != nil {
+			if ne, ok := err.(*strconv.NumError); !ok || ne.Err != test.err {
+				t.Errorf("ParseKanjiInt %q expected %v got %v", test.str, test.err, err)
+			}
+			continue
+		}
+		if err != nil || newN != test.num {
+			t.Errorf("ParseKanjiInt %v = %v %v", test.num, newN, err)
+		}
+	}
+}
+
+func TestParseKanjiInt_RoundTrip(t *testing.T) {
+	for i := int64(0); i < 1000000000000; i = i*3 + 7 {
+		for _, s := range [...]string{FmtInt64KanjiMeisuu(i), FmtInt64KanjiMeisuu(i, Issen), FmtIntKanji(int(i))} {
+			if s == "" {
+				continue
+			}
+			if newN, err := ParseKanjiInt(s); err != nil || newN != i {
+				t.Errorf("ParseKanjiInt %q = %v %v", s, newN, err)
+			}
+		}
+	}
+}
+
 type JpTimeTest struct {
 	time time.Time
 	str  string
@@ -220,16 +436,29 @@ type JpTimeWarekiTest struct {
 }
 
 var warekitests = []JpTimeWarekiTest{
-	{time.Date(0, time.December, 31, 0, 0, 0, 0, time.Local), "紀元前", "B.C.", 1},
-	{time.Date(1, time.January, 1, 0, 0, 0, 0, time.Local), "西暦", "A.D.", 1},
-	{time.Date(1873, time.January, 1, 0, 0, 0, 0, time.Local), "明治", "M", 6},
-	{time.Date(1912, time.July, 29, 0, 0, 0, 0, time.Local), "明治", "M", 45},
-	{time.Date(1912, time.July, 30, 0, 0, 0, 0, time.Local), "大正", "T", 1},
-	{time.Date(1926, time.December, 24, 0, 0, 0, 0, time.Local), "大正", "T", 15},
-	{time.Date(1926, time.December, 25, 0, 0, 0, 0, time.Local), "昭和", "S", 1},
-	{time.Date(1989, time.January, 7, 0, 0, 0, 0, time.Local), "昭和", "S", 64},
-	{time.Date(1989, time.January, 8, 0, 0, 0, 0, time.Local), "平成", "H", 1},
-	{time.Date(2016, time.January, 8, 0, 0, 0, 0, time.Local), "平成", "H", 28},
+	{time.Date(0, time.December, 31, 0, 0, 0, 0, jst), "紀元前", "B.C.", 1},
+	{time.Date(1, time.January, 1, 0, 0, 0, 0, jst), "西暦", "A.D.", 1},
+	{time.Date(600, time.January, 1, 0, 0, 0, 0, jst), "西暦", "A.D.", 600},
+	{time.Date(645, time.July, 20, 0, 0, 0, 0, jst), "大化", "", 1},
+	{time.Date(660, time.January, 1, 0, 0, 0, 0, jst), "西暦", "A.D.", 660},
+	{time.Date(1831, time.January, 23, 0, 0, 0, 0, jst), "天保", "", 1},
+	{time.Date(1831, time.February, 12, 0, 0, 0, 0, jst), "天保", "", 1},
+	{time.Date(1831, time.February, 13, 0, 0, 0, 0, jst), "天保", "", 2},
+	{time.Date(1843, time.March, 1, 0, 0, 0, 0, jst), "天保", "", 14},
+	{time.Date(1844, time.June, 1, 0, 0, 0, 0, jst), "天保", "", 15},
+	{time.Date(1868, time.October, 22, 0, 0, 0, 0, jst), "慶応", "", 4},
+	{time.Date(1868, time.October, 23, 0, 0, 0, 0, jst), "明治", "M", 1},
+	{time.Date(1873, time.January, 1, 0, 0, 0, 0, jst), "明治", "M", 6},
+	{time.Date(1912, time.July, 29, 0, 0, 0, 0, jst), "明治", "M", 45},
+	{time.Date(1912, time.July, 30, 0, 0, 0, 0, jst), "大正", "T", 1},
+	{time.Date(1926, time.December, 24, 0, 0, 0, 0, jst), "大正", "T", 15},
+	{time.Date(1926, time.December, 25, 0, 0, 0, 0, jst), "昭和", "S", 1},
+	{time.Date(1989, time.January, 7, 0, 0, 0, 0, jst), "昭和", "S", 64},
+	{time.Date(1989, time.January, 8, 0, 0, 0, 0, jst), "平成", "H", 1},
+	{time.Date(2016, time.January, 8, 0, 0, 0, 0, jst), "平成", "H", 28},
+	{time.Date(2019, time.April, 30, 0, 0, 0, 0, jst), "平成", "H", 31},
+	{time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), "令和", "R", 1},
+	{time.Date(2026, time.October, 18, 0, 0, 0, 0, jst), "令和", "R", 8},
 }
 
 func TestJpTime_Wareki(t *testing.T) {
@@ -248,6 +477,215 @@ func TestJpTime_Wareki(t *testing.T) {
 	}
 }
 
+type JpTimeEraTest struct {
+	time   time.Time
+	wareki Wareki
+	romaji string
+	str    string
+	start  time.Time
+	end    time.Time
+}
+
+var eratests = []JpTimeEraTest{
+	{time.Date(0, time.December, 31, 0, 0, 0, 0, jst), Kigenzen, "Kigenzen", "紀元前1年",
+		time.Time{}, time.Date(1, time.January, 1, 0, 0, 0, 0, jst)},
+	{time.Date(600, time.January, 1, 0, 0, 0, 0, jst), Seireki, "Seireki", "西暦600年",
+		time.Date(1, time.January, 1, 0, 0, 0, 0, jst), time.Date(1868, time.October, 23, 0, 0, 0, 0, jst)},
+	{time.Date(1843, time.March, 1, 0, 0, 0, 0, jst), PreMeiji, "Tenpō", "天保14年",
+		time.Date(1831, time.January, 23, 0, 0, 0, 0, jst), time.Date(1845, time.January, 9, 0, 0, 0, 0, jst)},
+	{time.Date(1831, time.February, 12, 0, 0, 0, 0, jst), PreMeiji, "Tenpō", "天保1年",
+		time.Date(1831, time.January, 23, 0, 0, 0, 0, jst), time.Date(1845, time.January, 9, 0, 0, 0, 0, jst)},
+	{time.Date(1868, time.October, 22, 0, 0, 0, 0, jst), PreMeiji, "Keiō", "慶応4年",
+		time.Date(1865, time.May, 1, 0, 0, 0, 0, jst), time.Date(1868, time.October, 23, 0, 0, 0, 0, jst)},
+	{time.Date(1926, time.December, 25, 0, 0, 0, 0, jst), Showa, "Shōwa", "昭和1年",
+		time.Date(1926, time.December, 25, 0, 0, 0, 0, jst), time.Date(1989, time.January, 8, 0, 0, 0, 0, jst)},
+	{time.Date(2016, time.January, 8, 0, 0, 0, 0, jst), Heisei, "Heisei", "平成28年",
+		time.Date(1989, time.January, 8, 0, 0, 0, 0, jst), time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)},
+	{time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), Reiwa, "Reiwa", "令和1年",
+		time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), time.Time{}},
+}
+
+func TestJpTime_Era(t *testing.T) {
+	for _, test := range eratests {
+		jpt := NewJpTime(test.time)
+		e := jpt.Era()
+		if e.Wareki != test.wareki || e.Romaji != test.romaji || e.String() != test.str {
+			t.Errorf("JpTime_Era %v %v %v = %v %v %v", test.wareki, test.romaji, test.str, e.Wareki, e.Romaji, e)
+		}
+		if !e.Start.Equal(test.start) || !e.End.Equal(test.end) {
+			t.Errorf("JpTime_Era %v %v-%v = %v-%v", test.str, test.start, test.end, e.Start, e.End)
+		}
+		if !e.Contains(jpt) {
+			t.Errorf("JpTime_Era %v does not contain %v", test.str, test.time)
+		}
+		if d := e.Date(e.Year, jpt.Month(), jpt.Day()); !d.Equal(jpt.Time) {
+			t.Errorf("JpTime_Era %v Date = %v", test.time, d)
+		}
+	}
+}
+
+func TestEra_Compare(t *testing.T) {
+	bc := NewJpTime(time.Date(-1, time.January, 1, 0, 0, 0, 0, jst)).Era()
+	h31 := NewJpTime(time.Date(2019, time.April, 30, 0, 0, 0, 0, jst)).Era()
+	r1 := NewJpTime(time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)).Era()
+	r2 := NewJpTime(time.Date(2020, time.May, 1, 0, 0, 0, 0, jst)).Era()
+	if !bc.Before(h31) || !h31.Before(r1) || !r1.Before(r2) || r2.Before(r1) || r1.Before(r1) {
+		t.Errorf("Era_Before %v %v %v %v", bc, h31, r1, r2)
+	}
+	if !r2.After(r1) || !r1.After(h31) || r1.After(r2) {
+		t.Errorf("Era_After %v %v %v", h31, r1, r2)
+	}
+	if !r1.Equal(NewJpTime(time.Date(2019, time.December, 31, 0, 0, 0, 0, jst)).Era()) || r1.Equal(h31) {
+		t.Errorf("Era_Equal %v %v", h31, r1)
+	}
+	if r1.Contains(NewJpTime(time.Date(2019, time.April, 30, 0, 0, 0, 0, jst))) {
+		t.Errorf("Era_Contains %v", r1)
+	}
+}
+
+type JpTimeCourtTest struct {
+	time  time.Time
+	court Court
+	name  string
+	year  int
+}
+
+var warekicourttests = []JpTimeCourtTest{
+	{time.Date(1330, time.January, 1, 0, 0, 0, 0, jst), Nanchou, "元徳", 2},
+	{time.Date(1330, time.January, 1, 0, 0, 0, 0, jst), Hokuchou, "元徳", 2},
+	{time.Date(1332, time.June, 1, 0, 0, 0, 0, jst), Nanchou, "元弘", 2},
+	{time.Date(1332, time.June, 1, 0, 0, 0, 0, jst), Hokuchou, "正慶", 1},
+	{time.Date(1335, time.January, 1, 0, 0, 0, 0, jst), Nanchou, "建武", 2},
+	{time.Date(1335, time.January, 1, 0, 0, 0, 0, jst), Hokuchou, "建武", 2},
+	{time.Date(1340, time.July, 1, 0, 0, 0, 0, jst), Nanchou, "興国", 1},
+	{time.Date(1340, time.July, 1, 0, 0, 0, 0, jst), Hokuchou, "暦応", 3},
+	{time.Date(1392, time.January, 1, 0, 0, 0, 0, jst), Nanchou, "元中", 9},
+	{time.Date(1392, time.January, 1, 0, 0, 0, 0, jst), Hokuchou, "明徳", 3},
+	{time.Date(1393, time.January, 1, 0, 0, 0, 0, jst), Nanchou, "明徳", 4},
+	{time.Date(1393, time.January, 1, 0, 0, 0, 0, jst), Hokuchou, "明徳", 4},
+}
+
+func TestJpTime_WarekiCourt(t *testing.T) {
+	for _, test := range warekicourttests {
+		jpt := NewJpTime(test.time)
+		newName, _, newYear := jpt.WarekiCourt(test.court)
+		if newName != test.name || newYear != test.year {
+			t.Errorf("JpTime_WarekiCourt %v %v = %v %v", test.name, test.year, newName, newYear)
+		}
+	}
+}
+
+// 改元日はいずれの元号でも元年.
+func TestJpTime_WarekiGannen(t *testing.T) {
+	for i, g := range gengo {
+		if g.name == "" || i > 0 && gengo[i-1].name == g.name {
+			continue
+		}
+		name, _, year := NewJpTime(g.start).WarekiCourt(g.court)
+		if name != g.name || year != 1 {
+			t.Errorf("JpTime_WarekiGannen %v %v = %v %v", g.name, g.start.Format("2006-01-02"), name, year)
+		}
+	}
+}
+
+func TestSetCourt(t *testing.T) {
+	defer SetCourt(Nanchou)
+
+	jpt := NewJpTime(time.Date(1340, time.July, 1, 0, 0, 0, 0, jst))
+	if name, _, _ := jpt.Wareki(); name != "興国" {
+		t.Errorf("SetCourt 興国 = %v", name)
+	}
+	SetCourt(Hokuchou)
+	if name, _, _ := jpt.Wareki(); name != "暦応" {
+		t.Errorf("SetCourt 暦応 = %v", name)
+	}
+}
+
+func TestJulian(t *testing.T) {
+	if j, g := julian(1582, time.October, 4), time.Date(1582, time.October, 14, 0, 0, 0, 0, jst); !j.Equal(g) {
+		t.Errorf("julian %v = %v", g, j)
+	}
+	if j, g := julian(645, time.July, 17), time.Date(645, time.July, 20, 0, 0, 0, 0, jst); !j.Equal(g) {
+		t.Errorf("julian %v = %v", g, j)
+	}
+}
+
+func TestRegisterEra(t *testing.T) {
+	saved := append([]jpTimeWareki(nil), wareki...)
+	defer func() { wareki = saved }()
+
+	start := time.Date(2100, time.January, 1, 0, 0, 0, 0, jst)
+	before := NewJpTime(start.AddDate(0, 0, -1))
+	after := NewJpTime(start)
+	if name, _, year := after.Wareki(); name != "令和" || year != 82 {
+		t.Errorf("RegisterEra before registration %v %v", name, year)
+	}
+
+	if err := RegisterEra("新元", "N", start); err != nil {
+		t.Fatalf("RegisterEra %v", err)
+	}
+	if name, initial, year := before.Wareki(); name != "令和" || initial != "R" || year != 81 {
+		t.Errorf("RegisterEra 令和 R 81 = %v %v %v", name, initial, year)
+	}
+	if name, initial, year := after.Wareki(); name != "新元" || initial != "N" || year != 1 {
+		t.Errorf("RegisterEra 新元 N 1 = %v %v %v", name, initial, year)
+	}
+	if s := after.JpFormat(JISX0301); s != "N01.01.01" {
+		t.Errorf("RegisterEra JISX0301 N01.01.01 = %v", s)
+	}
+	if s := after.JpFormat(WarekiDate); s != "新元1年1月1日" {
+		t.Errorf("RegisterEra WarekiDate 新元1年1月1日 = %v", s)
+	}
+
+	var registerErrTests = []struct {
+		name    string
+		initial string
+		start   time.Time
+	}{
+		{"", "X", start.AddDate(1, 0, 0)},
+		{"次元", "", start.AddDate(1, 0, 0)},
+		{"次元", "XY", start.AddDate(1, 0, 0)},
+		{"次元", "1", start.AddDate(1, 0, 0)},
+		{"次元", "Ｘ", start.AddDate(1, 0, 0)},
+		{"次元", "R", start.AddDate(1, 0, 0)},
+		{"次元", "n", start.AddDate(1, 0, 0)},
+		{"新元", "N", start.AddDate(1, 0, 0)},
+		{"次元", "X", start},
+		{"次元", "X", start.AddDate(-1, 0, 0)},
+	}
+	for _, test := range registerErrTests {
+		if err := RegisterEra(test.name, test.initial, test.start); err == nil {
+			t.Errorf("RegisterEra %q %q %v expected error", test.name, test.initial, test.start)
+		}
+	}
+}
+
+func TestRegisterEra_Concurrent(t *testing.T) {
+	saved := append([]jpTimeWareki(nil), wareki...)
+	defer func() { wareki = saved }()
+
+	jpt := NewJpTime(time.Date(2016, time.January, 8, 0, 0, 0, 0, time.Local))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if name, _, year := jpt.Wareki(); name != "平成" || year != 28 {
+					t.Errorf("RegisterEra_Concurrent 平成 28 = %v %v", name, year)
+				}
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		start := time.Date(2100+i, time.January, 1, 0, 0, 0, 0, time.Local)
+		if err := RegisterEra("新元"+FmtInt(i+1), "ABCDEFGIJK"[i:i+1], start); err != nil {
+			t.Errorf("RegisterEra_Concurrent %v", err)
+		}
+	}
+	wg.Wait()
+}
+
 var etotests = []JpTimeTest{
 	{time.Date(2008, time.January, 1, 0, 0, 0, 0, time.Local), "子"},
 	{time.Date(2009, time.January, 1, 0, 0, 0, 0, time.Local), "丑"},
@@ -420,6 +858,33 @@ func BenchmarkFmtIntKanjiMeisuu(b *testing.B) {
 	}
 }
 
+var benchmarkString string
+
+func BenchmarkFmtIntKanjiMeisuu_Large(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkString = FmtIntKanjiMeisuu(123456789 + i)
+	}
+}
+
+func BenchmarkAppendFmtIntKanjiMeisuu(b *testing.B) {
+	buf := make([]byte, 0, 256)
+	for i := 0; i < b.N; i++ {
+		buf = AppendFmtIntKanjiMeisuu(buf[:0], 9223372036854775807-int64(i))
+	}
+}
+
+func BenchmarkFmtInt64KanjiMeisuu(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkString = FmtInt64KanjiMeisuu(9223372036854775807 - int64(i))
+	}
+}
+
+func BenchmarkFmtInt64KanjiMeisuu_Issen(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkString = FmtInt64KanjiMeisuu(9223372036854775807-int64(i), Issen)
+	}
+}
+
 func BenchmarkJpTime_Holiday(b *testing.B) {
 	t := time.Date(2015, time.January, 1, 0, 0, 0, 0, time.Local)
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,336 @@
+package jptime
+
+import (
+	"math"
+	"time"
+)
+
+// A Court specifies the imperial court whose eras are used in the 南北朝 period.
+type Court int
+
+// These are the courts of the 南北朝 period.
+const (
+	anyCourt Court = iota
+	Nanchou        // 南朝（大覚寺統）
+	Hokuchou       // 北朝（持明院統）
+)
+
+// 明治以前の元号.
+// year は元年の西暦年、start は改元日（1582年以前はユリウス暦）.
+// name が空の行は元号の無い期間.
+type jpTimeGengo struct {
+	name    string
+	reading string
+	romaji  string
+	year    int
+	start   time.Time
+	court   Court
+}
+
+var gengo = [...]jpTimeGengo{
+	{"大化", "たいか", "Taika", 645, julian(645, time.July, 17), anyCourt},
+	{"白雉", "はくち", "Hakuchi", 650, julian(650, time.March, 22), anyCourt},
+	{"", "", "", 654, julian(654, time.November, 24), anyCourt},
+	{"朱鳥", "しゅちょう", "Shuchō", 686, julian(686, time.August, 14), anyCourt},
+	{"", "", "", 686, julian(686, time.October, 1), anyCourt},
+	{"大宝", "たいほう", "Taihō", 701, julian(701, time.May, 3), anyCourt},
+	{"慶雲", "けいうん", "Keiun", 704, julian(704, time.June, 16), anyCourt},
+	{"和銅", "わどう", "Wadō", 708, julian(708, time.February, 7), anyCourt},
+	{"霊亀", "れいき", "Reiki", 715, julian(715, time.October, 3), anyCourt},
+	{"養老", "ようろう", "Yōrō", 717, julian(717, time.December, 24), anyCourt},
+	{"神亀", "じんき", "Jinki", 724, julian(724, time.March, 3), anyCourt},
+	{"天平", "てんぴょう", "Tenpyō", 729, julian(729, time.September, 2), anyCourt},
+	{"天平感宝", "てんぴょうかんぽう", "Tenpyō-kanpō", 749, julian(749, time.May, 4), anyCourt},
+	{"天平勝宝", "てんぴょうしょうほう", "Tenpyō-shōhō", 749, julian(749, time.August, 19), anyCourt},
+	{"天平宝字", "てんぴょうほうじ", "Tenpyō-hōji", 757, julian(757, time.September, 6), anyCourt},
+	{"天平神護", "てんぴょうじんご", "Tenpyō-jingo", 765, julian(765, time.February, 1), anyCourt},
+	{"神護景雲", "じんごけいうん", "Jingo-keiun", 767, julian(767, time.September, 13), anyCourt},
+	{"宝亀", "ほうき", "Hōki", 770, julian(770, time.October, 23), anyCourt},
+	{"天応", "てんおう", "Ten'ō", 781, julian(781, time.January, 30), anyCourt},
+	{"延暦", "えんりゃく", "Enryaku", 782, julian(782, time.September, 30), anyCourt},
+	{"大同", "だいどう", "Daidō", 806, julian(806, time.June, 8), anyCourt},
+	{"弘仁", "こうにん", "Kōnin", 810, julian(810, time.October, 20), anyCourt},
+	{"天長", "てんちょう", "Tenchō", 824, julian(824, time.February, 8), anyCourt},
+	{"承和", "じょうわ", "Jōwa", 834, julian(834, time.February, 14), anyCourt},
+	{"嘉祥", "かしょう", "Kashō", 848, julian(848, time.July, 16), anyCourt},
+	{"仁寿", "にんじゅ", "Ninju", 851, julian(851, time.June, 1), anyCourt},
+	{"斉衡", "さいこう", "Saikō", 854, julian(854, time.December, 23), anyCourt},
+	{"天安", "てんあん", "Ten'an", 857, julian(857, time.March, 20), anyCourt},
+	{"貞観", "じょうがん", "Jōgan", 859, julian(859, time.May, 20), anyCourt},
+	{"元慶", "がんぎょう", "Gangyō", 877, julian(877, time.June, 1), anyCourt},
+	{"仁和", "にんな", "Ninna", 885, julian(885, time.March, 11), anyCourt},
+	{"寛平", "かんぴょう", "Kanpyō", 889, julian(889, time.May, 30), anyCourt},
+	{"昌泰", "しょうたい", "Shōtai", 898, julian(898, time.May, 20), anyCourt},
+	{"延喜", "えんぎ", "Engi", 901, julian(901, time.August, 31), anyCourt},
+	{"延長", "えんちょう", "Enchō", 923, julian(923, time.May, 29), anyCourt},
+	{"承平", "じょうへい", "Jōhei", 931, julian(931, time.May, 16), anyCourt},
+	{"天慶", "てんぎょう", "Tengyō", 938, julian(938, time.June, 22), anyCourt},
+	{"天暦", "てんりゃく", "Tenryaku", 947, julian(947, time.May, 15), anyCourt},
+	{"天徳", "てんとく", "Tentoku", 957, julian(957, time.November, 21), anyCourt},
+	{"応和", "おうわ", "Ōwa", 961, julian(961, time.March, 5), anyCourt},
+	{"康保", "こうほう", "Kōhō", 964, julian(964, time.August, 19), anyCourt},
+	{"安和", "あんな", "Anna", 968, julian(968, time.September, 8), anyCourt},
+	{"天禄", "てんろく", "Tenroku", 970, julian(970, time.May, 3), anyCourt},
+	{"天延", "てんえん", "Ten'en", 973, julian(974, time.January, 16), anyCourt},
+	{"貞元", "じょうげん", "Jōgen", 976, julian(976, time.August, 11), anyCourt},
+	{"天元", "てんげん", "Tengen", 978, julian(978, time.December, 31), anyCourt},
+	{"永観", "えいかん", "Eikan", 983, julian(983, time.May, 29), anyCourt},
+	{"寛和", "かんな", "Kanna", 985, julian(985, time.May, 19), anyCourt},
+	{"永延", "えいえん", "Eien", 987, julian(987, time.May, 5), anyCourt},
+	{"永祚", "えいそ", "Eiso", 989, julian(989, time.September, 10), anyCourt},
+	{"正暦", "しょうりゃく", "Shōryaku", 990, julian(990, time.November, 26), anyCourt},
+	{"長徳", "ちょうとく", "Chōtoku", 995, julian(995, time.March, 25), anyCourt},
+	{"長保", "ちょうほう", "Chōhō", 999, julian(999, time.February, 1), anyCourt},
+	{"寛弘", "かんこう", "Kankō", 1004, julian(1004, time.August, 8), anyCourt},
+	{"長和", "ちょうわ", "Chōwa", 1012, julian(1013, time.February, 8), anyCourt},
+	{"寛仁", "かんにん", "Kannin", 1017, julian(1017, time.May, 21), anyCourt},
+	{"治安", "じあん", "Jian", 1021, julian(1021, time.March, 17), anyCourt},
+	{"万寿", "まんじゅ", "Manju", 1024, julian(1024, time.August, 19), anyCourt},
+	{"長元", "ちょうげん", "Chōgen", 1028, julian(1028, time.August, 18), anyCourt},
+	{"長暦", "ちょうりゃく", "Chōryaku", 1037, julian(1037, time.May, 9), anyCourt},
+	{"長久", "ちょうきゅう", "Chōkyū", 1040, julian(1040, time.December, 16), anyCourt},
+	{"寛徳", "かんとく", "Kantoku", 1044, julian(1044, time.December, 16), anyCourt},
+	{"永承", "えいしょう", "Eishō", 1046, julian(1046, time.May, 22), anyCourt},
+	{"天喜", "てんぎ", "Tengi", 1053, julian(1053, time.February, 2), anyCourt},
+	{"康平", "こうへい", "Kōhei", 1058, julian(1058, time.September, 19), anyCourt},
+	{"治暦", "じりゃく", "Jiryaku", 1065, julian(1065, time.September, 4), anyCourt},
+	{"延久", "えんきゅう", "Enkyū", 1069, julian(1069, time.May, 6), anyCourt},
+	{"承保", "じょうほう", "Jōhō", 1074, julian(1074, time.September, 16), anyCourt},
+	{"承暦", "じょうりゃく", "Jōryaku", 1077, julian(1077, time.December, 5), anyCourt},
+	{"永保", "えいほう", "Eihō", 1081, julian(1081, time.March, 22), anyCourt},
+	{"応徳", "おうとく", "Ōtoku", 1084, julian(1084, time.March, 15), anyCourt},
+	{"寛治", "かんじ", "Kanji", 1087, julian(1087, time.May, 11), anyCourt},
+	{"嘉保", "かほう", "Kahō", 1094, julian(1095, time.January, 23), anyCourt},
+	{"永長", "えいちょう", "Eichō", 1096, julian(1097, time.January, 3), anyCourt},
+	{"承徳", "じょうとく", "Jōtoku", 1097, julian(1097, time.December, 27), anyCourt},
+	{"康和", "こうわ", "Kōwa", 1099, julian(1099, time.September, 15), anyCourt},
+	{"長治", "ちょうじ", "Chōji", 1104, julian(1104, time.March, 8), anyCourt},
+	{"嘉承", "かしょう", "Kashō", 1106, julian(1106, time.May, 13), anyCourt},
+	{"天仁", "てんにん", "Tennin", 1108, julian(1108, time.September, 9), anyCourt},
+	{"天永", "てんえい", "Ten'ei", 1110, julian(1110, time.July, 31), anyCourt},
+	{"永久", "えいきゅう", "Eikyū", 1113, julian(1113, time.August, 25), anyCourt},
+	{"元永", "げんえい", "Gen'ei", 1118, julian(1118, time.April, 25), anyCourt},
+	{"保安", "ほうあん", "Hōan", 1120, julian(1120, time.May, 9), anyCourt},
+	{"天治", "てんじ", "Tenji", 1124, julian(1124, time.May, 18), anyCourt},
+	{"大治", "だいじ", "Daiji", 1126, julian(1126, time.February, 15), anyCourt},
+	{"天承", "てんしょう", "Tenshō", 1131, julian(1131, time.February, 28), anyCourt},
+	{"長承", "ちょうしょう", "Chōshō", 1132, julian(1132, time.September, 21), anyCourt},
+	{"保延", "ほうえん", "Hōen", 1135, julian(1135, time.June, 10), anyCourt},
+	{"永治", "えいじ", "Eiji", 1141, julian(1141, time.August, 13), anyCourt},
+	{"康治", "こうじ", "Kōji", 1142, julian(1142, time.May, 25), anyCourt},
+	{"天養", "てんよう", "Ten'yō", 1144, julian(1144, time.March, 28), anyCourt},
+	{"久安", "きゅうあん", "Kyūan", 1145, julian(1145, time.August, 12), anyCourt},
+	{"仁平", "にんぺい", "Ninpei", 1151, julian(1151, time.February, 14), anyCourt},
+	{"久寿", "きゅうじゅ", "Kyūju", 1154, julian(1154, time.December, 4), anyCourt},
+	{"保元", "ほうげん", "Hōgen", 1156, julian(1156, time.May, 18), anyCourt},
+	{"平治", "へいじ", "Heiji", 1159, julian(1159, time.May, 9), anyCourt},
+	{"永暦", "えいりゃく", "Eiryaku", 1160, julian(1160, time.February, 18), anyCourt},
+	{"応保", "おうほう", "Ōhō", 1161, julian(1161, time.September, 24), anyCourt},
+	{"長寛", "ちょうかん", "Chōkan", 1163, julian(1163, time.May, 4), anyCourt},
+	{"永万", "えいまん", "Eiman", 1165, julian(1165, time.July, 14), anyCourt},
+	{"仁安", "にんあん", "Nin'an", 1166, julian(1166, time.September, 23), anyCourt},
+	{"嘉応", "かおう", "Kaō", 1169, julian(1169, time.May, 6), anyCourt},
+	{"承安", "じょうあん", "Jōan", 1171, julian(1171, time.May, 27), anyCourt},
+	{"安元", "あんげん", "Angen", 1175, julian(1175, time.August, 16), anyCourt},
+	{"治承", "じしょう", "Jishō", 1177, julian(1177, time.August, 29), anyCourt},
+	{"養和", "ようわ", "Yōwa", 1181, julian(1181, time.August, 25), anyCourt},
+	{"寿永", "じゅえい", "Juei", 1182, julian(1182, time.June, 29), anyCourt},
+	{"元暦", "げんりゃく", "Genryaku", 1184, julian(1184, time.May, 27), anyCourt},
+	{"文治", "ぶんじ", "Bunji", 1185, julian(1185, time.September, 9), anyCourt},
+	{"建久", "けんきゅう", "Kenkyū", 1190, julian(1190, time.May, 16), anyCourt},
+	{"正治", "しょうじ", "Shōji", 1199, julian(1199, time.May, 23), anyCourt},
+	{"建仁", "けんにん", "Kennin", 1201, julian(1201, time.March, 19), anyCourt},
+	{"元久", "げんきゅう", "Genkyū", 1204, julian(1204, time.March, 23), anyCourt},
+	{"建永", "けんえい", "Ken'ei", 1206, julian(1206, time.June, 5), anyCourt},
+	{"承元", "じょうげん", "Jōgen", 1207, julian(1207, time.November, 16), anyCourt},
+	{"建暦", "けんりゃく", "Kenryaku", 1211, julian(1211, time.April, 23), anyCourt},
+	{"建保", "けんぽう", "Kenpō", 1213, julian(1214, time.January, 18), anyCourt},
+	{"承久", "じょうきゅう", "Jōkyū", 1219, julian(1219, time.May, 27), anyCourt},
+	{"貞応", "じょうおう", "Jōō", 1222, julian(1222, time.May, 25), anyCourt},
+	{"元仁", "げんにん", "Gennin", 1224, julian(1225, time.January, 24), anyCourt},
+	{"嘉禄", "かろく", "Karoku", 1225, julian(1225, time.May, 28), anyCourt},
+	{"安貞", "あんてい", "Antei", 1227, julian(1228, time.January, 18), anyCourt},
+	{"寛喜", "かんぎ", "Kangi", 1229, julian(1229, time.March, 31), anyCourt},
+	{"貞永", "じょうえい", "Jōei", 1232, julian(1232, time.April, 23), anyCourt},
+	{"天福", "てんぷく", "Tenpuku", 1233, julian(1233, time.May, 25), anyCourt},
+	{"文暦", "ぶんりゃく", "Bunryaku", 1234, julian(1234, time.November, 27), anyCourt},
+	{"嘉禎", "かてい", "Katei", 1235, julian(1235, time.November, 1), anyCourt},
+	{"暦仁", "りゃくにん", "Ryakunin", 1238, julian(1238, time.December, 30), anyCourt},
+	{"延応", "えんおう", "En'ō", 1239, julian(1239, time.March, 13), anyCourt},
+	{"仁治", "にんじ", "Ninji", 1240, julian(1240, time.August, 5), anyCourt},
+	{"寛元", "かんげん", "Kangen", 1243, julian(1243, time.March, 18), anyCourt},
+	{"宝治", "ほうじ", "Hōji", 1247, julian(1247, time.April, 5), anyCourt},
+	{"建長", "けんちょう", "Kenchō", 1249, julian(1249, time.May, 2), anyCourt},
+	{"康元", "こうげん", "Kōgen", 1256, julian(1256, time.October, 24), anyCourt},
+	{"正嘉", "しょうか", "Shōka", 1257, julian(1257, time.March, 31), anyCourt},
+	{"正元", "しょうげん", "Shōgen", 1259, julian(1259, time.April, 20), anyCourt},
+	{"文応", "ぶんおう", "Bun'ō", 1260, julian(1260, time.May, 24), anyCourt},
+	{"弘長", "こうちょう", "Kōchō", 1261, julian(1261, time.March, 22), anyCourt},
+	{"文永", "ぶんえい", "Bun'ei", 1264, julian(1264, time.March, 27), anyCourt},
+	{"建治", "けんじ", "Kenji", 1275, julian(1275, time.May, 22), anyCourt},
+	{"弘安", "こうあん", "Kōan", 1278, julian(1278, time.March, 23), anyCourt},
+	{"正応", "しょうおう", "Shōō", 1288, julian(1288, time.May, 29), anyCourt},
+	{"永仁", "えいにん", "Einin", 1293, julian(1293, time.September, 6), anyCourt},
+	{"正安", "しょうあん", "Shōan", 1299, julian(1299, time.May, 25), anyCourt},
+	{"乾元", "けんげん", "Kengen", 1302, julian(1302, time.December, 10), anyCourt},
+	{"嘉元", "かげん", "Kagen", 1303, julian(1303, time.September, 16), anyCourt},
+	{"徳治", "とくじ", "Tokuji", 1306, julian(1307, time.January, 18), anyCourt},
+	{"延慶", "えんきょう", "Enkyō", 1308, julian(1308, time.November, 22), anyCourt},
+	{"応長", "おうちょう", "Ōchō", 1311, julian(1311, time.May, 17), anyCourt},
+	{"正和", "しょうわ", "Shōwa", 1312, julian(1312, time.April, 27), anyCourt},
+	{"文保", "ぶんぽう", "Bunpō", 1317, julian(1317, time.March, 16), anyCourt},
+	{"元応", "げんおう", "Gen'ō", 1319, julian(1319, time.May, 18), anyCourt},
+	{"元亨", "げんこう", "Genkō", 1321, julian(1321, time.March, 22), anyCourt},
+	{"正中", "しょうちゅう", "Shōchū", 1324, julian(1324, time.December, 25), anyCourt},
+	{"嘉暦", "かりゃく", "Karyaku", 1326, julian(1326, time.May, 28), anyCourt},
+	{"元徳", "げんとく", "Gentoku", 1329, julian(1329, time.September, 22), anyCourt},
+	{"元弘", "げんこう", "Genkō", 1331, julian(1331, time.September, 11), Nanchou},
+	{"正慶", "しょうけい", "Shōkei", 1332, julian(1332, time.May, 23), Hokuchou},
+	{"建武", "けんむ", "Kenmu", 1334, julian(1334, time.March, 5), anyCourt},
+	{"延元", "えんげん", "Engen", 1336, julian(1336, time.April, 11), Nanchou},
+	{"暦応", "りゃくおう", "Ryakuō", 1338, julian(1338, time.October, 11), Hokuchou},
+	{"興国", "こうこく", "Kōkoku", 1340, julian(1340, time.May, 25), Nanchou},
+	{"康永", "こうえい", "Kōei", 1342, julian(1342, time.June, 1), Hokuchou},
+	{"貞和", "じょうわ", "Jōwa", 1345, julian(1345, time.November, 15), Hokuchou},
+	{"正平", "しょうへい", "Shōhei", 1346, julian(1347, time.January, 20), Nanchou},
+	{"観応", "かんのう", "Kannō", 1350, julian(1350, time.April, 4), Hokuchou},
+	{"文和", "ぶんな", "Bunna", 1352, julian(1352, time.November, 4), Hokuchou},
+	{"延文", "えんぶん", "Enbun", 1356, julian(1356, time.April, 29), Hokuchou},
+	{"康安", "こうあん", "Kōan", 1361, julian(1361, time.May, 4), Hokuchou},
+	{"貞治", "じょうじ", "Jōji", 1362, julian(1362, time.October, 11), Hokuchou},
+	{"応安", "おうあん", "Ōan", 1368, julian(1368, time.March, 7), Hokuchou},
+	{"建徳", "けんとく", "Kentoku", 1370, julian(1370, time.August, 16), Nanchou},
+	{"文中", "ぶんちゅう", "Bunchū", 1372, julian(1372, time.May, 1), Nanchou},
+	{"永和", "えいわ", "Eiwa", 1375, julian(1375, time.March, 29), Hokuchou},
+	{"天授", "てんじゅ", "Tenju", 1375, julian(1375, time.June, 26), Nanchou},
+	{"康暦", "こうりゃく", "Kōryaku", 1379, julian(1379, time.April, 9), Hokuchou},
+	{"弘和", "こうわ", "Kōwa", 1381, julian(1381, time.March, 6), Nanchou},
+	{"永徳", "えいとく", "Eitoku", 1381, julian(1381, time.March, 20), Hokuchou},
+	{"至徳", "しとく", "Shitoku", 1384, julian(1384, time.March, 19), Hokuchou},
+	{"元中", "げんちゅう", "Genchū", 1384, julian(1384, time.May, 18), Nanchou},
+	{"嘉慶", "かけい", "Kakei", 1387, julian(1387, time.October, 5), Hokuchou},
+	{"康応", "こうおう", "Kōō", 1389, julian(1389, time.March, 7), Hokuchou},
+	{"明徳", "めいとく", "Meitoku", 1390, julian(1390, time.April, 12), Hokuchou},
+	{"明徳", "めいとく", "Meitoku", 1390, julian(1392, time.November, 19), Nanchou},
+	{"応永", "おうえい", "Ōei", 1394, julian(1394, time.August, 2), anyCourt},
+	{"正長", "しょうちょう", "Shōchō", 1428, julian(1428, time.June, 10), anyCourt},
+	{"永享", "えいきょう", "Eikyō", 1429, julian(1429, time.October, 3), anyCourt},
+	{"嘉吉", "かきつ", "Kakitsu", 1441, julian(1441, time.March, 10), anyCourt},
+	{"文安", "ぶんあん", "Bun'an", 1444, julian(1444, time.February, 23), anyCourt},
+	{"宝徳", "ほうとく", "Hōtoku", 1449, julian(1449, time.August, 16), anyCourt},
+	{"享徳", "きょうとく", "Kyōtoku", 1452, julian(1452, time.August, 10), anyCourt},
+	{"康正", "こうしょう", "Kōshō", 1455, julian(1455, time.September, 6), anyCourt},
+	{"長禄", "ちょうろく", "Chōroku", 1457, julian(1457, time.October, 16), anyCourt},
+	{"寛正", "かんしょう", "Kanshō", 1460, julian(1461, time.February, 1), anyCourt},
+	{"文正", "ぶんしょう", "Bunshō", 1466, julian(1466, time.March, 14), anyCourt},
+	{"応仁", "おうにん", "Ōnin", 1467, julian(1467, time.April, 9), anyCourt},
+	{"文明", "ぶんめい", "Bunmei", 1469, julian(1469, time.June, 8), anyCourt},
+	{"長享", "ちょうきょう", "Chōkyō", 1487, julian(1487, time.August, 9), anyCourt},
+	{"延徳", "えんとく", "Entoku", 1489, julian(1489, time.September, 16), anyCourt},
+	{"明応", "めいおう", "Meiō", 1492, julian(1492, time.August, 12), anyCourt},
+	{"文亀", "ぶんき", "Bunki", 1501, julian(1501, time.March, 18), anyCourt},
+	{"永正", "えいしょう", "Eishō", 1504, julian(1504, time.March, 16), anyCourt},
+	{"大永", "たいえい", "Daiei", 1521, julian(1521, time.September, 23), anyCourt},
+	{"享禄", "きょうろく", "Kyōroku", 1528, julian(1528, time.September, 3), anyCourt},
+	{"天文", "てんぶん", "Tenbun", 1532, julian(1532, time.August, 29), anyCourt},
+	{"弘治", "こうじ", "Kōji", 1555, julian(1555, time.November, 7), anyCourt},
+	{"永禄", "えいろく", "Eiroku", 1558, julian(1558, time.March, 18), anyCourt},
+	{"元亀", "げんき", "Genki", 1570, julian(1570, time.May, 27), anyCourt},
+	{"天正", "てんしょう", "Tenshō", 1573, julian(1573, time.August, 25), anyCourt},
+	{"文禄", "ぶんろく", "Bunroku", 1592, time.Date(1593, time.January, 10, 0, 0, 0, 0, jst), anyCourt},
+	{"慶長", "けいちょう", "Keichō", 1596, time.Date(1596, time.December, 16, 0, 0, 0, 0, jst), anyCourt},
+	{"元和", "げんな", "Genna", 1615, time.Date(1615, time.September, 5, 0, 0, 0, 0, jst), anyCourt},
+	{"寛永", "かんえい", "Kan'ei", 1624, time.Date(1624, time.April, 17, 0, 0, 0, 0, jst), anyCourt},
+	{"正保", "しょうほ", "Shōho", 1644, time.Date(1645, time.January, 13, 0, 0, 0, 0, jst), anyCourt},
+	{"慶安", "けいあん", "Keian", 1648, time.Date(1648, time.April, 7, 0, 0, 0, 0, jst), anyCourt},
+	{"承応", "じょうおう", "Jōō", 1652, time.Date(1652, time.October, 20, 0, 0, 0, 0, jst), anyCourt},
+	{"明暦", "めいれき", "Meireki", 1655, time.Date(1655, time.May, 18, 0, 0, 0, 0, jst), anyCourt},
+	{"万治", "まんじ", "Manji", 1658, time.Date(1658, time.August, 21, 0, 0, 0, 0, jst), anyCourt},
+	{"寛文", "かんぶん", "Kanbun", 1661, time.Date(1661, time.May, 23, 0, 0, 0, 0, jst), anyCourt},
+	{"延宝", "えんぽう", "Enpō", 1673, time.Date(1673, time.October, 30, 0, 0, 0, 0, jst), anyCourt},
+	{"天和", "てんな", "Tenna", 1681, time.Date(1681, time.November, 9, 0, 0, 0, 0, jst), anyCourt},
+	{"貞享", "じょうきょう", "Jōkyō", 1684, time.Date(1684, time.April, 5, 0, 0, 0, 0, jst), anyCourt},
+	{"元禄", "げんろく", "Genroku", 1688, time.Date(1688, time.October, 23, 0, 0, 0, 0, jst), anyCourt},
+	{"宝永", "ほうえい", "Hōei", 1704, time.Date(1704, time.April, 16, 0, 0, 0, 0, jst), anyCourt},
+	{"正徳", "しょうとく", "Shōtoku", 1711, time.Date(1711, time.June, 11, 0, 0, 0, 0, jst), anyCourt},
+	{"享保", "きょうほう", "Kyōhō", 1716, time.Date(1716, time.August, 9, 0, 0, 0, 0, jst), anyCourt},
+	{"元文", "げんぶん", "Genbun", 1736, time.Date(1736, time.June, 7, 0, 0, 0, 0, jst), anyCourt},
+	{"寛保", "かんぽう", "Kanpō", 1741, time.Date(1741, time.April, 12, 0, 0, 0, 0, jst), anyCourt},
+	{"延享", "えんきょう", "Enkyō", 1744, time.Date(1744, time.April, 3, 0, 0, 0, 0, jst), anyCourt},
+	{"寛延", "かんえん", "Kan'en", 1748, time.Date(1748, time.August, 5, 0, 0, 0, 0, jst), anyCourt},
+	{"宝暦", "ほうれき", "Hōreki", 1751, time.Date(1751, time.December, 14, 0, 0, 0, 0, jst), anyCourt},
+	{"明和", "めいわ", "Meiwa", 1764, time.Date(1764, time.June, 30, 0, 0, 0, 0, jst), anyCourt},
+	{"安永", "あんえい", "An'ei", 1772, time.Date(1772, time.December, 10, 0, 0, 0, 0, jst), anyCourt},
+	{"天明", "てんめい", "Tenmei", 1781, time.Date(1781, time.April, 25, 0, 0, 0, 0, jst), anyCourt},
+	{"寛政", "かんせい", "Kansei", 1789, time.Date(1789, time.February, 19, 0, 0, 0, 0, jst), anyCourt},
+	{"享和", "きょうわ", "Kyōwa", 1801, time.Date(1801, time.March, 19, 0, 0, 0, 0, jst), anyCourt},
+	{"文化", "ぶんか", "Bunka", 1804, time.Date(1804, time.March, 22, 0, 0, 0, 0, jst), anyCourt},
+	{"文政", "ぶんせい", "Bunsei", 1818, time.Date(1818, time.May, 26, 0, 0, 0, 0, jst), anyCourt},
+	{"天保", "てんぽう", "Tenpō", 1830, time.Date(1831, time.January, 23, 0, 0, 0, 0, jst), anyCourt},
+	{"弘化", "こうか", "Kōka", 1844, time.Date(1845, time.January, 9, 0, 0, 0, 0, jst), anyCourt},
+	{"嘉永", "かえい", "Kaei", 1848, time.Date(1848, time.April, 1, 0, 0, 0, 0, jst), anyCourt},
+	{"安政", "あんせい", "Ansei", 1854, time.Date(1855, time.January, 15, 0, 0, 0, 0, jst), anyCourt},
+	{"万延", "まんえん", "Man'en", 1860, time.Date(1860, time.April, 8, 0, 0, 0, 0, jst), anyCourt},
+	{"文久", "ぶんきゅう", "Bunkyū", 1861, time.Date(1861, time.March, 29, 0, 0, 0, 0, jst), anyCourt},
+	{"元治", "げんじ", "Genji", 1864, time.Date(1864, time.March, 27, 0, 0, 0, 0, jst), anyCourt},
+	{"慶応", "けいおう", "Keiō", 1865, time.Date(1865, time.May, 1, 0, 0, 0, 0, jst), anyCourt},
+}
+
+// 南北朝の元号はデフォルトで南朝（正統）を使う.
+var court = Nanchou
+
+// SetCourt sets the court used by Wareki for the 南北朝 period.
+func SetCourt(c Court) {
+	warekiMu.Lock()
+	defer warekiMu.Unlock()
+	court = c
+}
+
+// 明治以前の元号と次の元号の改元日.
+// 年は西暦年から換算する（旧暦の年始は考慮しない）.
+func (t JpTime) gengo(c Court) (jpTimeGengo, time.Time, bool) {
+	end := wareki[Meiji].start
+	for i := len(gengo) - 1; i >= 0; i-- {
+		g := gengo[i]
+		if g.court != anyCourt && g.court != c {
+			continue
+		}
+		if !t.Before(g.start) {
+			return g, end, g.name != ""
+		}
+		end = g.start
+	}
+	return jpTimeGengo{}, end, false
+}
+
+// 旧暦の元日の近似値. 雨水（2月19日頃）以前で最後の朔の日とする.
+func kyuurekiNewYear(year int) time.Time {
+	jd := float64(time.Date(year, time.February, 20, 0, 0, 0, 0, jst).Unix())/86400 + 2440587.5
+	k := math.Floor((jd - 2451550.09766) / 29.530588861)
+	nm := newMoon(k)
+	if nm > jd {
+		nm = newMoon(k - 1)
+	}
+	t := time.Unix(int64((nm-2440587.5)*86400), 0).In(jst)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, jst)
+}
+
+// k 番目（2000年1月6日を0とする）の朔のユリウス日.
+// Meeus, Astronomical Algorithms 49章の主要項のみによる.
+func newMoon(k float64) float64 {
+	const rad = math.Pi / 180
+	m := (2.5534 + 29.10535670*k) * rad     // 太陽の平均近点角
+	mm := (201.5643 + 385.81693528*k) * rad // 月の平均近点角
+	f := (160.7108 + 390.67050284*k) * rad  // 月の緯度引数
+	return 2451550.09766 + 29.530588861*k -
+		0.40720*math.Sin(mm) + 0.17241*math.Sin(m) + 0.01608*math.Sin(2*mm) + 0.01039*math.Sin(2*f)
+}
+
+// julian returns the time of the date in the julian calendar.
+func julian(year int, month time.Month, day int) time.Time {
+	a := (14 - int(month)) / 12
+	y := year + 4800 - a
+	m := int(month) + 12*a - 3
+	jdn := day + (153*m+2)/5 + 365*y + y/4 - 32083
+	return time.Date(1970, time.January, 1+jdn-2440588, 0, 0, 0, 0, jst)
+}
@@ -0,0 +1,119 @@
+package jptime
+
+import (
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+// time.Local によらず同じ結果になること.
+type JpTimeLocalTest struct {
+	name string
+	f    func() string
+	str  string
+}
+
+var localtests = []JpTimeLocalTest{
+	{"Wareki", func() string {
+		name, _, year := NewJpTime(time.Date(1868, time.October, 23, 0, 0, 0, 0, jst)).Wareki()
+		return name + FmtInt(year)
+	}, "明治1"},
+	{"Wareki_Gengo", func() string {
+		name, _, year := NewJpTime(time.Date(1831, time.January, 23, 0, 0, 0, 0, jst)).Wareki()
+		return name + FmtInt(year)
+	}, "天保1"},
+	{"WarekiCourt", func() string {
+		name, _, year := NewJpTime(time.Date(1340, time.May, 25, 0, 0, 0, 0, jst).Add(-time.Second)).WarekiCourt(Nanchou)
+		return name + FmtInt(year)
+	}, "延元5"},
+	{"Era.Date", func() string {
+		return NewJpTime(time.Date(1831, time.February, 1, 0, 0, 0, 0, jst)).Era().Date(1, time.January, 23).Format("2006-01-02 15:04")
+	}, "1831-01-23 00:00"},
+	{"JpParse", func() string {
+		t, _ := JpParse(WarekiDate, "天保14年3月2日")
+		return t.Format("2006-01-02 15:04")
+	}, "1843-03-02 00:00"},
+	{"JpParse_KanjiTime", func() string {
+		t, _ := JpParse(KanjiTime, "十五時四分五秒")
+		return t.Format("15:04:05")
+	}, "15:04:05"},
+	{"ParseAny", func() string {
+		t, _ := ParseAny("H18.1.2")
+		return t.Format("2006-01-02 15:04")
+	}, "2006-01-02 00:00"},
+	{"JpFormat", func() string {
+		return NewJpTime(time.Date(2019, time.April, 30, 23, 0, 0, 0, jst)).JpFormat("平成元年1月2日 15時")
+	}, "平成31年4月30日 23時"},
+	{"Holiday", func() string {
+		_, name := NewJpTime(time.Date(2019, time.May, 6, 0, 0, 0, 0, jst)).Holiday()
+		return name
+	}, "振替休日"},
+	{"HolidaysInYear", func() string {
+		h := HolidaysInYear(2019)[7]
+		return h.Date.Format("2006-01-02 15:04 ") + h.Name
+	}, "2019-05-02 00:00 国民の休日"},
+	{"HolidaysBetween", func() string {
+		h := HolidaysBetween(NewJpTime(time.Date(2019, time.May, 6, 0, 0, 0, 0, jst)), NewJpTime(time.Date(2019, time.May, 6, 0, 0, 0, 0, jst)))
+		return FmtInt(len(h)) + " " + h[0].Name
+	}, "1 振替休日"},
+	{"NextHoliday", func() string {
+		h, _ := NewJpTime(time.Date(2019, time.April, 30, 23, 0, 0, 0, jst)).NextHoliday()
+		return h.Date.Format("2006-01-02 ") + h.Name
+	}, "2019-05-01 即位の日"},
+	{"PrevHoliday", func() string {
+		h, _ := NewJpTime(time.Date(2019, time.May, 1, 0, 0, 0, 0, jst)).PrevHoliday()
+		return h.Date.Format("2006-01-02 ") + h.Name
+	}, "2019-04-30 国民の休日"},
+	{"AddBusinessDays", func() string {
+		return NewJpTime(time.Date(2019, time.April, 26, 23, 0, 0, 0, jst)).AddBusinessDays(1).Format("2006-01-02 15:04")
+	}, "2019-05-07 23:00"},
+	{"BusinessDaysBetween", func() string {
+		return FmtInt(BusinessDaysBetween(NewJpTime(time.Date(2019, time.April, 26, 0, 0, 0, 0, jst)), NewJpTime(time.Date(2019, time.May, 8, 0, 0, 0, 0, jst))))
+	}, "2"},
+	{"Calendar", func() string {
+		c := NewCalendar()
+		c.CloseEveryYear(time.December, 31, "年末年始")
+		c.Close(NewJpTime(time.Date(2019, time.June, 3, 0, 0, 0, 0, jst)), "創立記念日")
+		_, name := c.Closed(NewJpTime(time.Date(2019, time.December, 31, 0, 0, 0, 0, jst)))
+		return c.NextBusinessDay(NewJpTime(time.Date(2019, time.May, 31, 0, 0, 0, 0, jst))).Format("2006-01-02 ") + name
+	}, "2019-06-04 年末年始"},
+	{"NewBankCalendar", func() string {
+		return NewBankCalendar().Adjust(NewJpTime(time.Date(2018, time.December, 31, 0, 0, 0, 0, jst)), Following).Format("2006-01-02 15:04")
+	}, "2019-01-04 00:00"},
+	{"TSE", func() string {
+		e := NewTSE()
+		s := e.Sessions(NewJpTime(time.Date(2024, time.November, 5, 0, 0, 0, 0, jst)))[0]
+		return s.Open.Format("2006-01-02 15:04 ") + e.Daihakkai(2025).Format("2006-01-02 ") + e.Dainoukai(2024).Format("2006-01-02")
+	}, "2024-11-05 09:00 2025-01-06 2024-12-30"},
+	{"SetHolidayOverrides", func() string {
+		defer SetHolidayOverrides(nil)
+		overrides, err := ReadHolidayOverridesYAML(strings.NewReader("- date: 2026-06-07\n  name: 臨時の祝日\n"))
+		if err == nil {
+			err = SetHolidayOverrides(overrides)
+		}
+		if err != nil {
+			return err.Error()
+		}
+		_, name := NewJpTime(time.Date(2026, time.June, 8, 0, 0, 0, 0, jst)).Holiday()
+		return overrides[0].Date.Format("2006-01-02 15:04 ") + name
+	}, "2026-06-07 00:00 振替休日"},
+}
+
+func TestLocal(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := time.Local
+	defer func() { time.Local = saved }()
+
+	for _, loc := range []*time.Location{time.FixedZone("UTC+11", 11*60*60), time.FixedZone("UTC-5", -5*60*60), tokyo} {
+		time.Local = loc
+		for _, test := range localtests {
+			if s := test.f(); s != test.str {
+				t.Errorf("Local %v %v %v = %v", loc, test.name, test.str, s)
+			}
+		}
+	}
+}
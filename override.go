@@ -0,0 +1,199 @@
+package jptime
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// A HolidayOverride adds, renames or removes a holiday on a date.
+// It is used when holidays are changed by law before a release of jptime.
+//
+// The overrides take precedence over the rules of jptime:
+// a date with Remove is never a holiday, and a date with Name is a 国民の祝日 of that name,
+// replacing the holiday of the rules on the date if any.
+// 振替休日 and 国民の休日 are computed from the overridden 国民の祝日.
+type HolidayOverride struct {
+	Date   JpTime
+	Name   string
+	Remove bool
+}
+
+type holidayOverride struct {
+	name   string
+	remove bool
+}
+
+// SetHolidayOverrides replaces the holiday overrides with overrides.
+// The dates must be from 1948 to 2099 and unique, and each override must have either Name or Remove.
+// If overrides is invalid, the current overrides are kept.
+// SetHolidayOverrides(nil) removes all the overrides.
+func SetHolidayOverrides(overrides []HolidayOverride) error {
+	m := make(map[int]holidayOverride, len(overrides))
+	for _, o := range overrides {
+		ymd := o.Date.ymd()
+		date := o.Date.Format("2006-01-02")
+		switch {
+		case o.Date.Year() < holidayFirstYear || o.Date.Year() > holidayLastYear:
+			return errors.New("jptime: holiday override " + date + " is out of range")
+		case o.Remove && o.Name != "":
+			return errors.New("jptime: holiday override " + date + " has both name and remove")
+		case !o.Remove && o.Name == "":
+			return errors.New("jptime: holiday override " + date + " has neither name nor remove")
+		}
+		if _, ok := m[ymd]; ok {
+			return errors.New("jptime: holiday override " + date + " is duplicated")
+		}
+		m[ymd] = holidayOverride{o.Name, o.Remove}
+	}
+	if len(m) == 0 {
+		m = nil
+	}
+
+	holidayTablesMu.Lock()
+	defer holidayTablesMu.Unlock()
+	holidayOverrides = m
+	holidayTables = make(map[int]*holidayTable)
+	holidayGen++
+	return nil
+}
+
+// LoadHolidayOverrides reads the holiday overrides from the file
+// by ReadHolidayOverridesCSV, ReadHolidayOverridesJSON or ReadHolidayOverridesYAML
+// according to its extension (.csv, .json, .yaml or .yml) and sets them by SetHolidayOverrides.
+func LoadHolidayOverrides(name string) error {
+	var read func(io.Reader) ([]HolidayOverride, error)
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		read = ReadHolidayOverridesCSV
+	case ".json":
+		read = ReadHolidayOverridesJSON
+	case ".yaml", ".yml":
+		read = ReadHolidayOverridesYAML
+	default:
+		return errors.New("jptime: unknown holiday override format " + strconv.Quote(name))
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	overrides, err := read(f)
+	if err != nil {
+		return err
+	}
+	return SetHolidayOverrides(overrides)
+}
+
+// ReadHolidayOverridesCSV reads the holiday overrides in CSV (UTF-8) such as
+//
+//	date,name,remove
+//	2026-06-10,臨時の祝日
+//	2026-11-03,,true
+//
+// The header line and the remove column are optional.
+func ReadHolidayOverridesCSV(r io.Reader) ([]HolidayOverride, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	var overrides []HolidayOverride
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return overrides, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(strings.TrimPrefix(record[0], "\uFEFF"), "date") {
+			continue
+		}
+		if len(record) < 2 || len(record) > 3 {
+			return nil, overrideError(line, "want date,name[,remove]")
+		}
+		o := HolidayOverride{Name: record[1]}
+		if o.Date, err = parseOverrideDate(strings.TrimPrefix(record[0], "\uFEFF")); err != nil {
+			return nil, overrideError(line, err.Error())
+		}
+		if len(record) == 3 && record[2] != "" {
+			if o.Remove, err = strconv.ParseBool(record[2]); err != nil {
+				return nil, overrideError(line, "invalid remove "+strconv.Quote(record[2]))
+			}
+		}
+		overrides = append(overrides, o)
+	}
+}
+
+// ReadHolidayOverridesJSON reads the holiday overrides in JSON such as
+//
+//	[
+//		{"date": "2026-06-10", "name": "臨時の祝日"},
+//		{"date": "2026-11-03", "remove": true}
+//	]
+func ReadHolidayOverridesJSON(r io.Reader) ([]HolidayOverride, error) {
+	var records []holidayOverrideRecord
+	d := json.NewDecoder(r)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&records); err != nil {
+		return nil, errors.New("jptime: " + err.Error())
+	}
+	return holidayOverridesOf(records)
+}
+
+// ReadHolidayOverridesYAML reads the holiday overrides in YAML such as
+//
+//	# 2026年の変更
+//	- date: 2026-06-10
+//	  name: 臨時の祝日
+//	- date: 2026-11-03
+//	  remove: true
+func ReadHolidayOverridesYAML(r io.Reader) ([]HolidayOverride, error) {
+	var records []holidayOverrideRecord
+	d := yaml.NewDecoder(r)
+	d.KnownFields(true)
+	if err := d.Decode(&records); err != nil && err != io.EOF {
+		return nil, errors.New("jptime: " + err.Error())
+	}
+	return holidayOverridesOf(records)
+}
+
+// JSON・YAML の1件.
+type holidayOverrideRecord struct {
+	Date   string `json:"date" yaml:"date"`
+	Name   string `json:"name" yaml:"name"`
+	Remove bool   `json:"remove" yaml:"remove"`
+}
+
+func holidayOverridesOf(records []holidayOverrideRecord) ([]HolidayOverride, error) {
+	overrides := make([]HolidayOverride, len(records))
+	for i, rec := range records {
+		t, err := parseOverrideDate(rec.Date)
+		if err != nil {
+			return nil, errors.New("jptime: entry " + strconv.Itoa(i+1) + ": " + err.Error())
+		}
+		overrides[i] = HolidayOverride{t, rec.Name, rec.Remove}
+	}
+	return overrides, nil
+}
+
+// 2006-01-02.
+func parseOverrideDate(s string) (JpTime, error) {
+	t, err := time.ParseInLocation("2006-01-02", s, jst)
+	if err != nil {
+		return JpTime{}, errors.New("invalid date " + strconv.Quote(s))
+	}
+	return NewJpTime(t), nil
+}
+
+func overrideError(line int, msg string) error {
+	return errors.New("jptime: line " + strconv.Itoa(line) + ": " + msg)
+}
@@ -0,0 +1,247 @@
+package jptime
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func overrideDate(year int, month time.Month, day int) JpTime {
+	return NewJpTime(time.Date(year, month, day, 0, 0, 0, 0, jst))
+}
+
+type holidayOverrideTest struct {
+	overrides []HolidayOverride
+	date      JpTime
+	isHoliday bool
+	name      string
+	kind      HolidayKind
+}
+
+var holidayoverridetests = []holidayOverrideTest{
+	// 削除
+	{[]HolidayOverride{{Date: overrideDate(2026, time.November, 3), Remove: true}}, overrideDate(2026, time.November, 3), false, "", 0},
+	// 名称の変更
+	{[]HolidayOverride{{Date: overrideDate(2026, time.November, 3), Name: "新文化の日"}}, overrideDate(2026, time.November, 3), true, "新文化の日", Shukujitsu},
+	// 追加
+	{[]HolidayOverride{{Date: overrideDate(2026, time.June, 10), Name: "臨時の祝日"}}, overrideDate(2026, time.June, 10), true, "臨時の祝日", Shukujitsu},
+	// 国民の休日・振替休日を祝日にする
+	{[]HolidayOverride{{Date: overrideDate(2026, time.September, 22), Name: "臨時の祝日"}}, overrideDate(2026, time.September, 22), true, "臨時の祝日", Shukujitsu},
+	{[]HolidayOverride{{Date: overrideDate(2026, time.May, 6), Name: "臨時の祝日"}}, overrideDate(2026, time.May, 6), true, "臨時の祝日", Shukujitsu},
+	// 日曜日の追加による振替休日
+	{[]HolidayOverride{{Date: overrideDate(2026, time.June, 7), Name: "臨時の祝日"}}, overrideDate(2026, time.June, 8), true, "振替休日", Furikae},
+	// 振替休日の連鎖
+	{[]HolidayOverride{{Date: overrideDate(2026, time.May, 6), Name: "臨時の祝日"}}, overrideDate(2026, time.May, 7), true, "振替休日", Furikae},
+	// 年をまたぐ振替休日・国民の休日
+	{[]HolidayOverride{{Date: overrideDate(2028, time.December, 31), Name: "臨時の祝日"}}, overrideDate(2029, time.January, 2), true, "振替休日", Furikae},
+	{[]HolidayOverride{{Date: overrideDate(2027, time.December, 30), Name: "臨時の祝日"}}, overrideDate(2027, time.December, 31), true, "国民の休日", Kokumin},
+	// 祝日に挟まれた日
+	{[]HolidayOverride{{Date: overrideDate(2026, time.June, 10), Name: "臨時の祝日"}, {Date: overrideDate(2026, time.June, 12), Name: "臨時の祝日"}}, overrideDate(2026, time.June, 11), true, "国民の休日", Kokumin},
+	// 削除により国民の休日でなくなる
+	{[]HolidayOverride{{Date: overrideDate(2026, time.September, 23), Remove: true}}, overrideDate(2026, time.September, 22), false, "", 0},
+	// 国民の休日・振替休日の削除
+	{[]HolidayOverride{{Date: overrideDate(2026, time.September, 22), Remove: true}}, overrideDate(2026, time.September, 22), false, "", 0},
+	{[]HolidayOverride{{Date: overrideDate(2026, time.May, 6), Remove: true}}, overrideDate(2026, time.May, 6), false, "", 0},
+	{[]HolidayOverride{{Date: overrideDate(2026, time.September, 22), Remove: true}}, overrideDate(2026, time.September, 23), true, "秋分の日", Shukujitsu},
+	// 他の日は変わらない
+	{[]HolidayOverride{{Date: overrideDate(2026, time.November, 3), Remove: true}}, overrideDate(2026, time.November, 23), true, "勤労感謝の日", Shukujitsu},
+}
+
+func TestSetHolidayOverrides(t *testing.T) {
+	defer SetHolidayOverrides(nil)
+	for _, tt := range holidayoverridetests {
+		if err := SetHolidayOverrides(tt.overrides); err != nil {
+			t.Fatalf("SetHolidayOverrides(%v) error: %v", tt.overrides, err)
+		}
+		h, ok := tt.date.holidayEntry()
+		if ok != tt.isHoliday || h.Name != tt.name || (ok && h.Kind != tt.kind) {
+			t.Errorf("%v with %v = %v, %v, %v; want %v, %v, %v", tt.date.Format("2006-01-02"), tt.overrides, ok, h.Name, h.Kind, tt.isHoliday, tt.name, tt.kind)
+		}
+	}
+
+	if err := SetHolidayOverrides(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, name := overrideDate(2026, time.November, 3).Holiday(); name != "文化の日" {
+		t.Errorf("SetHolidayOverrides(nil) does not restore the holidays: %v", name)
+	}
+}
+
+var setholidayoverrideserrortests = []struct {
+	overrides []HolidayOverride
+	err       string
+}{
+	{[]HolidayOverride{{Date: overrideDate(1947, time.May, 3), Name: "憲法記念日"}}, "jptime: holiday override 1947-05-03 is out of range"},
+	{[]HolidayOverride{{Date: overrideDate(2100, time.January, 1), Name: "元日"}}, "jptime: holiday override 2100-01-01 is out of range"},
+	{[]HolidayOverride{{Date: overrideDate(2026, time.June, 10), Name: "臨時の祝日", Remove: true}}, "jptime: holiday override 2026-06-10 has both name and remove"},
+	{[]HolidayOverride{{Date: overrideDate(2026, time.June, 10)}}, "jptime: holiday override 2026-06-10 has neither name nor remove"},
+	{[]HolidayOverride{{Date: overrideDate(2026, time.June, 10), Name: "臨時の祝日"}, {Date: overrideDate(2026, time.June, 10), Remove: true}}, "jptime: holiday override 2026-06-10 is duplicated"},
+}
+
+func TestSetHolidayOverrides_Error(t *testing.T) {
+	defer SetHolidayOverrides(nil)
+	current := []HolidayOverride{{Date: overrideDate(2026, time.November, 3), Remove: true}}
+	if err := SetHolidayOverrides(current); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range setholidayoverrideserrortests {
+		if err := SetHolidayOverrides(tt.overrides); err == nil || err.Error() != tt.err {
+			t.Errorf("SetHolidayOverrides(%v) error = %v, want %v", tt.overrides, err, tt.err)
+		}
+	}
+	if isHoliday, _ := overrideDate(2026, time.November, 3).Holiday(); isHoliday {
+		t.Errorf("SetHolidayOverrides with an error changes the overrides")
+	}
+}
+
+var readholidayoverridestests = []struct {
+	read  func(io.Reader) ([]HolidayOverride, error)
+	input string
+}{
+	{
+		ReadHolidayOverridesCSV,
+		"date,name,remove\n2026-06-10,臨時の祝日\n2026-11-03,,true\n",
+	},
+	{
+		ReadHolidayOverridesCSV,
+		"\uFEFF2026-06-10,臨時の祝日,false\r\n2026-11-03, , true\r\n",
+	},
+	{
+		ReadHolidayOverridesJSON,
+		`[{"date": "2026-06-10", "name": "臨時の祝日"}, {"date": "2026-11-03", "remove": true}]`,
+	},
+	{
+		ReadHolidayOverridesYAML,
+		"# 2026年の変更\n- date: 2026-06-10\n  name: 臨時の祝日 # 追加\n- date: 2026-11-03\n  remove: true\n",
+	},
+	{
+		ReadHolidayOverridesYAML,
+		"---\n-\n  date: \"2026-06-10\"\n  name: '臨時の祝日'\r\n- remove: true\n  date: 2026-11-03\n",
+	},
+	{
+		ReadHolidayOverridesYAML,
+		"- {date: 2026-06-10, name: 臨時の祝日}\n- {date: 2026-11-03, remove: true}\n",
+	},
+	{
+		ReadHolidayOverridesYAML,
+		"[{\"date\": \"2026-06-10\", \"name\": \"臨時の祝日\"}, {\"date\": \"2026-11-03\", \"remove\": true}]",
+	},
+	{
+		ReadHolidayOverridesYAML,
+		"- date:\t2026-06-10\n  name:\t臨時の祝日\t# 追加\n- date:\t2026-11-03\n  remove:\ttrue\n",
+	},
+}
+
+func TestReadHolidayOverrides(t *testing.T) {
+	want := []HolidayOverride{
+		{Date: overrideDate(2026, time.June, 10), Name: "臨時の祝日"},
+		{Date: overrideDate(2026, time.November, 3), Remove: true},
+	}
+	for _, tt := range readholidayoverridestests {
+		got, err := tt.read(strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("read %q error: %v", tt.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("read %q = %v, want %v", tt.input, got, want)
+		}
+	}
+}
+
+var readholidayoverrideserrortests = []struct {
+	format string
+	input  string
+	err    string
+}{
+	{"csv", "2026-06-10\n", "jptime: line 1: want date,name[,remove]"},
+	{"csv", "date,name\n2026/6/10,臨時の祝日\n", `jptime: line 2: invalid date "2026/6/10"`},
+	{"csv", "2026-02-30,臨時の祝日\n", `jptime: line 1: invalid date "2026-02-30"`},
+	{"csv", "2026-06-10,,maybe\n", `jptime: line 1: invalid remove "maybe"`},
+	{"json", `[{"date": "2026-06-10", "nmae": "臨時の祝日"}]`, `jptime: json: unknown field "nmae"`},
+	{"json", `[{"date": "June 10", "name": "臨時の祝日"}]`, `jptime: entry 1: invalid date "June 10"`},
+	{"yaml", "date: 2026-06-10\n", "jptime: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!map into []jptime.holidayOverrideRecord"},
+	{"yaml", "- date: 2026-06-10\n  nmae: 臨時の祝日\n", "jptime: yaml: unmarshal errors:\n  line 2: field nmae not found in type jptime.holidayOverrideRecord"},
+	{"yaml", "- date: 2026-06-10\n  remove: maybe\n", "jptime: yaml: unmarshal errors:\n  line 2: cannot unmarshal !!str `maybe` into bool"},
+	{"yaml", "- name: 臨時の祝日\n- date: 2026-06-10\n", `jptime: entry 1: invalid date ""`},
+	{"yaml", "- date: 2026-06-10\n- date: 2026/6/10\n", `jptime: entry 2: invalid date "2026/6/10"`},
+}
+
+func TestReadHolidayOverrides_Error(t *testing.T) {
+	for _, tt := range readholidayoverrideserrortests {
+		var err error
+		switch tt.format {
+		case "csv":
+			_, err = ReadHolidayOverridesCSV(strings.NewReader(tt.input))
+		case "json":
+			_, err = ReadHolidayOverridesJSON(strings.NewReader(tt.input))
+		case "yaml":
+			_, err = ReadHolidayOverridesYAML(strings.NewReader(tt.input))
+		}
+		if err == nil || err.Error() != tt.err {
+			t.Errorf("read %s %q error = %v, want %v", tt.format, tt.input, err, tt.err)
+		}
+	}
+}
+
+func TestLoadHolidayOverrides(t *testing.T) {
+	defer SetHolidayOverrides(nil)
+	dir := t.TempDir()
+	files := map[string]string{
+		"holidays.csv":  "2026-06-10,臨時の祝日\n",
+		"holidays.json": `[{"date": "2026-06-10", "name": "臨時の祝日"}]`,
+		"holidays.YML":  "- date: 2026-06-10\n  name: 臨時の祝日\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		SetHolidayOverrides(nil)
+		if err := LoadHolidayOverrides(path); err != nil {
+			t.Errorf("LoadHolidayOverrides(%v) error: %v", name, err)
+			continue
+		}
+		if _, got := overrideDate(2026, time.June, 10).Holiday(); got != "臨時の祝日" {
+			t.Errorf("LoadHolidayOverrides(%v): Holiday = %q, want 臨時の祝日", name, got)
+		}
+	}
+
+	if err := LoadHolidayOverrides(filepath.Join(dir, "holidays.txt")); err == nil {
+		t.Errorf("LoadHolidayOverrides(holidays.txt) error = nil")
+	}
+	if err := LoadHolidayOverrides(filepath.Join(dir, "missing.csv")); err == nil {
+		t.Errorf("LoadHolidayOverrides(missing.csv) error = nil")
+	}
+}
+
+func TestSetHolidayOverrides_Concurrent(t *testing.T) {
+	defer SetHolidayOverrides(nil)
+	overrides := []HolidayOverride{{Date: overrideDate(2026, time.June, 10), Name: "臨時の祝日"}}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if i == 0 {
+					SetHolidayOverrides(overrides[:j%2])
+					continue
+				}
+				overrideDate(2026, time.June, 10).Holiday()
+				HolidaysInYear(2026 + j%2)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	SetHolidayOverrides(overrides)
+	if _, name := overrideDate(2026, time.June, 10).Holiday(); name != "臨時の祝日" {
+		t.Errorf("Holiday after SetHolidayOverrides = %q, want 臨時の祝日", name)
+	}
+}
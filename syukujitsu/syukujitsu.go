@@ -0,0 +1,142 @@
+// Package syukujitsu reads the list of 国民の祝日 published by the Cabinet Office
+// (https://www8.cao.go.jp/chosei/shukujitsu/syukujitsu.csv) and compares it with jptime.
+package syukujitsu
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackpopper/jptime"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// An Entry is a line of syukujitsu.csv.
+type Entry struct {
+	Date jptime.JpTime
+	Name string
+}
+
+// Read reads syukujitsu.csv encoded in Shift_JIS.
+// The header line (国民の祝日・休日月日,国民の祝日・休日名称) is skipped.
+func Read(r io.Reader) ([]Entry, error) {
+	cr := csv.NewReader(transform.NewReader(r, japanese.ShiftJIS.NewDecoder()))
+	cr.FieldsPerRecord = 2
+	var entries []Entry
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && !strings.ContainsAny(record[0], "0123456789") {
+			continue
+		}
+		t, err := parseDate(record[0])
+		if err != nil {
+			return nil, errors.New("syukujitsu: line " + strconv.Itoa(line) + ": " + err.Error())
+		}
+		entries = append(entries, Entry{t, record[1]})
+	}
+}
+
+// syukujitsu.csv の日付は日本標準時.
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// 2006/1/2.
+func parseDate(s string) (jptime.JpTime, error) {
+	fields := strings.Split(s, "/")
+	if len(fields) != 3 {
+		return jptime.JpTime{}, errors.New("invalid date " + strconv.Quote(s))
+	}
+	var nums [3]int
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return jptime.JpTime{}, errors.New("invalid date " + strconv.Quote(s))
+		}
+		nums[i] = n
+	}
+	t := time.Date(nums[0], time.Month(nums[1]), nums[2], 0, 0, 0, 0, jst)
+	if t.Month() != time.Month(nums[1]) || t.Day() != nums[2] {
+		return jptime.JpTime{}, errors.New("invalid date " + strconv.Quote(s))
+	}
+	return jptime.NewJpTime(t), nil
+}
+
+// syukujitsu.csv での名称.
+var csvNames = map[string]string{
+	"即位の日":         "休日（祝日扱い）",
+	"皇太子明仁親王の結婚の儀": "結婚の儀",
+	"皇太子徳仁親王の結婚の儀": "結婚の儀",
+	"昭和天皇の大喪の礼":    "大喪の礼",
+}
+
+// Name returns the name of h in syukujitsu.csv.
+// 振替休日 and 国民の休日 are 休日, and the holidays of 2019 by the accession are 休日（祝日扱い）.
+func Name(h jptime.HolidayEntry) string {
+	switch {
+	case h.Kind != jptime.Shukujitsu:
+		return "休日"
+	case h.Name == "即位礼正殿の儀" && h.Date.Year() == 2019:
+		return "休日（祝日扱い）"
+	}
+	if name, ok := csvNames[h.Name]; ok {
+		return name
+	}
+	return h.Name
+}
+
+// A Mismatch is a date on which syukujitsu.csv and jptime differ.
+// Want is the name in syukujitsu.csv and Got is the name by jptime, empty if not a holiday.
+type Mismatch struct {
+	Date jptime.JpTime
+	Want string
+	Got  string
+}
+
+func (m Mismatch) String() string {
+	return m.Date.Format("2006/1/2") + ": " + strconv.Quote(m.Want) + " != " + strconv.Quote(m.Got)
+}
+
+// Diff compares entries with jptime.HolidaysBetween from the first to the last date of entries,
+// ignoring dates before from, and returns the mismatches in date order.
+func Diff(entries []Entry, from jptime.JpTime) []Mismatch {
+	if len(entries) == 0 {
+		return nil
+	}
+	first, last := entries[0].Date, entries[0].Date
+	want := make(map[string]string, len(entries))
+	for _, e := range entries {
+		if e.Date.Before(first.Time) {
+			first = e.Date
+		}
+		if e.Date.After(last.Time) {
+			last = e.Date
+		}
+		want[e.Date.Format("2006/1/2")] = e.Name
+	}
+	if first.Before(from.Time) {
+		first = from
+	}
+
+	got := make(map[string]string)
+	for _, h := range jptime.HolidaysBetween(first, last) {
+		got[h.Date.Format("2006/1/2")] = Name(h)
+	}
+
+	var mismatches []Mismatch
+	for t := first; !t.After(last.Time); t = jptime.NewJpTime(t.AddDate(0, 0, 1)) {
+		key := t.Format("2006/1/2")
+		if want[key] != got[key] {
+			mismatches = append(mismatches, Mismatch{t, want[key], got[key]})
+		}
+	}
+	return mismatches
+}
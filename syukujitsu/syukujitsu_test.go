@@ -0,0 +1,120 @@
+package syukujitsu
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackpopper/jptime"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func date(year int, month time.Month, day int) jptime.JpTime {
+	return jptime.NewJpTime(time.Date(year, month, day, 0, 0, 0, 0, jst))
+}
+
+func shiftJIS(t *testing.T, s string) *bytes.Reader {
+	b, err := japanese.ShiftJIS.NewEncoder().Bytes([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(b)
+}
+
+// testdata/syukujitsu.csv は内閣府の syukujitsu.csv の2016〜2026年の抜粋.
+// 全体に置き換えれば1955年以降を比較する.
+func TestDiff_Testdata(t *testing.T) {
+	f, err := os.Open("testdata/syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	entries, err := Read(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) == 0 {
+		t.Fatal("Read returned no entries")
+	}
+	for _, m := range Diff(entries, date(1955, time.January, 1)) {
+		t.Error(m)
+	}
+}
+
+func TestRead(t *testing.T) {
+	entries, err := Read(shiftJIS(t, "国民の祝日・休日月日,国民の祝日・休日名称\r\n2019/5/1,休日（祝日扱い）\r\n2019/5/2,休日\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Read = %v", entries)
+	}
+	if e := entries[0]; !e.Date.Equal(date(2019, time.May, 1).Time) || e.Name != "休日（祝日扱い）" {
+		t.Errorf("Read[0] = %v %v", e.Date, e.Name)
+	}
+}
+
+func TestRead_Error(t *testing.T) {
+	for _, s := range []string{
+		"2019/5/1\r\n",
+		"2019/5/1,休日\r\n2019/5,休日\r\n",
+		"2019/2/30,休日\r\n",
+		"2019/May/1,休日\r\n",
+	} {
+		if entries, err := Read(shiftJIS(t, s)); err == nil {
+			t.Errorf("Read %q = %v, want error", s, entries)
+		}
+	}
+}
+
+var nametests = []struct {
+	date time.Time
+	name string
+}{
+	{time.Date(1959, time.April, 10, 0, 0, 0, 0, jst), "結婚の儀"},
+	{time.Date(1989, time.February, 24, 0, 0, 0, 0, jst), "大喪の礼"},
+	{time.Date(1990, time.November, 12, 0, 0, 0, 0, jst), "即位礼正殿の儀"},
+	{time.Date(1993, time.June, 9, 0, 0, 0, 0, jst), "結婚の儀"},
+	{time.Date(2019, time.May, 1, 0, 0, 0, 0, jst), "休日（祝日扱い）"},
+	{time.Date(2019, time.May, 2, 0, 0, 0, 0, jst), "休日"},
+	{time.Date(2019, time.May, 6, 0, 0, 0, 0, jst), "休日"},
+	{time.Date(2019, time.October, 22, 0, 0, 0, 0, jst), "休日（祝日扱い）"},
+	{time.Date(2019, time.October, 14, 0, 0, 0, 0, jst), "体育の日"},
+}
+
+func TestName(t *testing.T) {
+	for _, test := range nametests {
+		d := jptime.NewJpTime(test.date)
+		holidays := jptime.HolidaysBetween(d, d)
+		if len(holidays) != 1 {
+			t.Fatalf("HolidaysBetween %v = %v", test.date, holidays)
+		}
+		if name := Name(holidays[0]); name != test.name {
+			t.Errorf("Name %v = %v, want %v", test.date, name, test.name)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	entries := []Entry{
+		{date(2019, time.January, 1), "元日"},
+		{date(2019, time.January, 14), "成人の日"},
+		{date(2019, time.January, 20), "休日"},
+		{date(2019, time.February, 11), "建国記念日"},
+	}
+	var got []string
+	for _, m := range Diff(entries, date(1955, time.January, 1)) {
+		got = append(got, m.String())
+	}
+	want := []string{
+		`2019/1/20: "休日" != ""`,
+		`2019/2/11: "建国記念日" != "建国記念の日"`,
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Diff = %q, want %q", got, want)
+	}
+	if m := Diff(entries, date(2019, time.February, 1)); len(m) != 1 {
+		t.Errorf("Diff from 2019/2/1 = %v", m)
+	}
+}
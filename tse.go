@@ -0,0 +1,115 @@
+package jptime
+
+import "time"
+
+// A Session is a trading session such as 前場 or 後場.
+type Session struct {
+	Name  string
+	Open  JpTime
+	Close JpTime
+}
+
+// A TSE is the trading calendar of 東京証券取引所.
+// Its Calendar is closed on Saturdays, Sundays, holidays and from December 31 to January 3.
+// Saturday sessions before 1989 are not modeled.
+type TSE struct {
+	*Calendar
+}
+
+// NewTSE returns the trading calendar of 東京証券取引所.
+func NewTSE() *TSE {
+	c := NewCalendar()
+	c.CloseEveryYear(time.December, 31, "年末年始")
+	c.CloseEveryYear(time.January, 1, "年末年始")
+	c.CloseEveryYear(time.January, 2, "年末年始")
+	c.CloseEveryYear(time.January, 3, "年末年始")
+	for _, d := range tseClosures {
+		c.Close(NewJpTime(time.Date(d.ymd/10000, time.Month(d.ymd/100%100), d.ymd%100, 0, 0, 0, 0, jst)), d.name)
+	}
+	return &TSE{c}
+}
+
+// 立会時間 (時分).
+type tseSession struct {
+	name        string
+	open, close int
+}
+
+// 立会時間の変更 (YYYYMMDD から).
+var tseSessionRules = [...]struct {
+	from     int
+	sessions []tseSession
+}{
+	{0, []tseSession{{"前場", 900, 1100}, {"後場", 1230, 1500}}},
+	{20111121, []tseSession{{"前場", 900, 1130}, {"後場", 1230, 1500}}},
+	{20241105, []tseSession{{"前場", 900, 1130}, {"後場", 1230, 1530}}},
+}
+
+// 立会時間の臨時変更.
+var tseSessionExceptions = map[int][]tseSession{
+	20051101: {{"後場", 1330, 1500}},                    // システム障害により前場休止
+	20060118: {{"前場", 900, 1100}, {"後場", 1230, 1440}}, // 売買停止のため取引時間短縮
+}
+
+// 終日売買停止.
+var tseClosures = [...]struct {
+	ymd  int
+	name string
+}{
+	{20201001, "システム障害による終日売買停止"},
+}
+
+// IsTradingDay reports whether the date of t is a trading day.
+func (e *TSE) IsTradingDay(t JpTime) bool { return e.IsBusinessDay(t) }
+
+// NextTradingDay returns the first trading day after t.
+func (e *TSE) NextTradingDay(t JpTime) JpTime { return e.NextBusinessDay(t) }
+
+// PrevTradingDay returns the last trading day before t.
+func (e *TSE) PrevTradingDay(t JpTime) JpTime { return e.PrevBusinessDay(t) }
+
+// Sessions returns the sessions on the date of t, or nil if it is not a trading day.
+func (e *TSE) Sessions(t JpTime) []Session {
+	if !e.IsTradingDay(t) {
+		return nil
+	}
+	ymd := t.ymd()
+	sessions, ok := tseSessionExceptions[ymd]
+	if !ok {
+		for _, r := range tseSessionRules {
+			if r.from <= ymd {
+				sessions = r.sessions
+			}
+		}
+	}
+
+	clock := func(hm int) JpTime {
+		return NewJpTime(time.Date(t.Year(), t.Month(), t.Day(), hm/100, hm%100, 0, 0, t.Location()))
+	}
+	result := make([]Session, len(sessions))
+	for i, s := range sessions {
+		result[i] = Session{s.name, clock(s.open), clock(s.close)}
+	}
+	return result
+}
+
+// IsTrading reports whether t is within a session.
+// The close instant of a session is not included.
+func (e *TSE) IsTrading(t JpTime) bool {
+	for _, s := range e.Sessions(t) {
+		if !t.Before(s.Open.Time) && t.Before(s.Close.Time) {
+			return true
+		}
+	}
+	return false
+}
+
+// Daihakkai returns 大発会, the first trading day of year.
+func (e *TSE) Daihakkai(year int) JpTime {
+	return e.Adjust(NewJpTime(time.Date(year, time.January, 1, 0, 0, 0, 0, jst)), Following)
+}
+
+// Dainoukai returns 大納会, the last trading day of year.
+func (e *TSE) Dainoukai(year int) JpTime {
+	return e.Adjust(NewJpTime(time.Date(year, time.December, 31, 0, 0, 0, 0, jst)), Preceding)
+}
@@ -0,0 +1,80 @@
+package jptime
+
+import (
+	"testing"
+	"time"
+)
+
+var tsesessionstests = []struct {
+	time     time.Time
+	sessions string
+}{
+	{time.Date(2011, time.November, 18, 0, 0, 0, 0, jst), "前場 09:00-11:00 後場 12:30-15:00"},
+	{time.Date(2011, time.November, 21, 0, 0, 0, 0, jst), "前場 09:00-11:30 後場 12:30-15:00"},
+	{time.Date(2024, time.November, 1, 0, 0, 0, 0, jst), "前場 09:00-11:30 後場 12:30-15:00"},
+	{time.Date(2024, time.November, 5, 0, 0, 0, 0, jst), "前場 09:00-11:30 後場 12:30-15:30"},
+	{time.Date(2024, time.November, 4, 0, 0, 0, 0, jst), ""},
+	{time.Date(2005, time.November, 1, 0, 0, 0, 0, jst), "後場 13:30-15:00"},
+	{time.Date(2006, time.January, 18, 0, 0, 0, 0, jst), "前場 09:00-11:00 後場 12:30-14:40"},
+	{time.Date(2020, time.October, 1, 0, 0, 0, 0, jst), ""},
+	{time.Date(2020, time.October, 2, 0, 0, 0, 0, jst), "前場 09:00-11:30 後場 12:30-15:00"},
+	{time.Date(2019, time.December, 31, 0, 0, 0, 0, jst), ""},
+	{time.Date(2020, time.January, 3, 0, 0, 0, 0, jst), ""},
+}
+
+func TestTSE_Sessions(t *testing.T) {
+	e := NewTSE()
+	for _, test := range tsesessionstests {
+		result := ""
+		for _, s := range e.Sessions(NewJpTime(test.time)) {
+			if result != "" {
+				result += " "
+			}
+			result += s.Name + " " + s.Open.Format("15:04") + "-" + s.Close.Format("15:04")
+		}
+		if result != test.sessions {
+			t.Errorf("TSE_Sessions %v = %q, want %q", test.time, result, test.sessions)
+		}
+	}
+}
+
+var tseistradingtests = []struct {
+	time time.Time
+	ok   bool
+}{
+	{time.Date(2024, time.November, 5, 8, 59, 59, 0, jst), false},
+	{time.Date(2024, time.November, 5, 9, 0, 0, 0, jst), true},
+	{time.Date(2024, time.November, 5, 11, 30, 0, 0, jst), false},
+	{time.Date(2024, time.November, 5, 12, 0, 0, 0, jst), false},
+	{time.Date(2024, time.November, 5, 15, 29, 59, 0, jst), true},
+	{time.Date(2024, time.November, 5, 15, 30, 0, 0, jst), false},
+	{time.Date(2024, time.November, 1, 15, 10, 0, 0, jst), false},
+	{time.Date(2024, time.November, 2, 10, 0, 0, 0, jst), false},
+}
+
+func TestTSE_IsTrading(t *testing.T) {
+	e := NewTSE()
+	for _, test := range tseistradingtests {
+		if ok := e.IsTrading(NewJpTime(test.time)); ok != test.ok {
+			t.Errorf("TSE_IsTrading %v = %v", test.time, ok)
+		}
+	}
+}
+
+func TestTSE_Daihakkai(t *testing.T) {
+	e := NewTSE()
+	for year, want := range map[int]string{2019: "2019-01-04", 2020: "2020-01-06", 2023: "2023-01-04"} {
+		if d := e.Daihakkai(year).Format("2006-01-02"); d != want {
+			t.Errorf("TSE_Daihakkai %d = %v, want %v", year, d, want)
+		}
+	}
+	for year, want := range map[int]string{2019: "2019-12-30", 2023: "2023-12-29", 2017: "2017-12-29"} {
+		if d := e.Dainoukai(year).Format("2006-01-02"); d != want {
+			t.Errorf("TSE_Dainoukai %d = %v, want %v", year, d, want)
+		}
+	}
+	day := NewJpTime(time.Date(2020, time.September, 30, 0, 0, 0, 0, jst))
+	if next := e.NextTradingDay(day); next.Day() != 2 {
+		t.Errorf("TSE_NextTradingDay %v = %v", day, next)
+	}
+}